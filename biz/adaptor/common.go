@@ -65,3 +65,8 @@ func PostProcess(ctx context.Context, c *app.RequestContext, req, resp any, err
 		}
 	}
 }
+
+// Disabled 拦截未启用功能的接口, 如同接口不存在
+func Disabled(ctx context.Context, c *app.RequestContext) {
+	c.AbortWithStatus(hertz.StatusNotFound)
+}
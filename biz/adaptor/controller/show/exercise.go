@@ -91,3 +91,67 @@ func LikeExercise(ctx context.Context, c *app.RequestContext) {
 	resp, err := p.ExerciseService.LikeExercise(ctx, &req)
 	adaptor.PostProcess(ctx, c, &req, resp, err)
 }
+
+// ReportQuestion .
+// @router /exercise/question/report [POST]
+func ReportQuestion(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ReportQuestionReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ExerciseService.ReportQuestion(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListQuestionReports .
+// @router /exercise/question/report/list [POST]
+func ListQuestionReports(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListQuestionReportsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ExerciseService.ListQuestionReports(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// DeleteExercise .
+// @router /exercise/delete [POST]
+func DeleteExercise(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.DeleteExerciseReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ExerciseService.DeleteExercise(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RegenerateExercise .
+// @router /exercise/regenerate [POST]
+func RegenerateExercise(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.RegenerateExerciseReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ExerciseService.RegenerateExercise(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
@@ -266,3 +266,1049 @@ func SubmitFeedback(ctx context.Context, c *app.RequestContext) {
 	resp, err := p.FeedBackService.Submit(ctx, &req)
 	adaptor.PostProcess(ctx, c, &req, resp, err)
 }
+
+// GetRank .
+// @router /rank/get [POST]
+func GetRank(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetRankReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.RankService.GetRank(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// UpdateRankPrivacy .
+// @router /rank/privacy [POST]
+func UpdateRankPrivacy(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.UpdateRankPrivacyReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.RankService.UpdateRankPrivacy(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListAchievements .
+// @router /achievement/list [POST]
+func ListAchievements(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListAchievementsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AchievementService.ListAchievements(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// MakeUpAttend .
+// @router /user/daily_attend/make_up [POST]
+func MakeUpAttend(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.MakeUpAttendReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.UserService.MakeUpAttend(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetQuotaHistory .
+// @router /user/quota/history [POST]
+func GetQuotaHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetQuotaHistoryReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.QuotaService.GetQuotaHistory(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// AuditQuota .
+// @router /admin/quota/audit [POST]
+func AuditQuota(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.AuditQuotaReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.QuotaService.AuditQuota(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreateVoucherBatch .
+// @router /admin/voucher/create [POST]
+func CreateVoucherBatch(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.CreateVoucherBatchReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.VoucherService.CreateVoucherBatch(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RedeemVoucher .
+// @router /user/voucher/redeem [POST]
+func RedeemVoucher(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.RedeemVoucherReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.VoucherService.RedeemVoucher(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GrantPlan .
+// @router /admin/plan/grant [POST]
+func GrantPlan(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GrantPlanReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.PlanService.GrantPlan(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListProducts .
+// @router /order/products [POST]
+func ListProducts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListProductsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.OrderService.ListProducts(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreateOrder .
+// @router /order/create [POST]
+func CreateOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.CreateOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.OrderService.CreateOrder(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetOrder .
+// @router /order/get [POST]
+func GetOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.OrderService.GetOrder(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListOrders .
+// @router /order/list [POST]
+func ListOrders(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListOrdersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.OrderService.ListOrders(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RefundOrder .
+// @router /admin/order/refund [POST]
+func RefundOrder(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.RefundOrderReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.OrderService.RefundOrder(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// PaymentNotify 接收支付网关的支付结果回调, 需要原始请求体验签, 因此不做参数绑定
+// @router /order/notify [POST]
+func PaymentNotify(ctx context.Context, c *app.RequestContext) {
+	headers := make(map[string]string)
+	c.Request.Header.VisitAll(func(key, value []byte) {
+		headers[string(key)] = string(value)
+	})
+
+	p := provider.Get()
+	resp, err := p.OrderService.PaymentNotify(ctx, headers, c.Request.Body())
+	if err != nil {
+		c.JSON(consts.StatusBadRequest, resp)
+		return
+	}
+	c.JSON(consts.StatusOK, resp)
+}
+
+// ListInvitees .
+// @router /user/invitation/list [POST]
+func ListInvitees(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListInviteesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.UserService.ListInvitees(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListInvitationReviews .
+// @router /admin/invitation/list [POST]
+func ListInvitationReviews(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListInvitationReviewsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.UserService.ListInvitationReviews(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ReviewInvitation .
+// @router /admin/invitation/review [POST]
+func ReviewInvitation(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ReviewInvitationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.UserService.ReviewInvitation(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// UpdateInvitationCode .
+// @router /admin/invitation/update [POST]
+func UpdateInvitationCode(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.UpdateInvitationCodeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.UserService.UpdateInvitationCode(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// SetUserRole .
+// @router /admin/user/role [POST]
+func SetUserRole(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.SetUserRoleReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.UserService.SetUserRole(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// SearchUsers .
+// @router /admin/user/search [POST]
+func SearchUsers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.SearchUsersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AdminService.SearchUsers(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetUserDetail .
+// @router /admin/user/detail [POST]
+func GetUserDetail(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetUserDetailReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AdminService.GetUserDetail(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// AdjustQuota .
+// @router /admin/user/quota [POST]
+func AdjustQuota(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.AdjustQuotaReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AdminService.AdjustQuota(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// BanUser .
+// @router /admin/user/ban [POST]
+func BanUser(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.BanUserReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AdminService.BanUser(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ForceLogout .
+// @router /admin/user/logout [POST]
+func ForceLogout(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ForceLogoutReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AdminService.ForceLogout(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListAuditLogs .
+// @router /admin/audit/list [POST]
+func ListAuditLogs(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListAuditLogsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AdminService.ListAuditLogs(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListMyFeedbacks .
+// @router /feedback/list [POST]
+func ListMyFeedbacks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListMyFeedbacksReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.FeedBackService.ListMyFeedbacks(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListFeedbacks .
+// @router /admin/feedback/list [POST]
+func ListFeedbacks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListFeedbacksReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.FeedBackService.ListFeedbacks(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// AssignFeedback .
+// @router /admin/feedback/assign [POST]
+func AssignFeedback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.AssignFeedbackReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.FeedBackService.AssignFeedback(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// UpdateFeedbackStatus .
+// @router /admin/feedback/status [POST]
+func UpdateFeedbackStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.UpdateFeedbackStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.FeedBackService.UpdateFeedbackStatus(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ReplyFeedback .
+// @router /admin/feedback/reply [POST]
+func ReplyFeedback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ReplyFeedbackReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.FeedBackService.ReplyFeedback(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetFeedback .
+// @router /admin/feedback/get [POST]
+func GetFeedback(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetFeedbackReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.FeedBackService.GetFeedback(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// FeedbackStats .
+// @router /admin/feedback/stats [POST]
+func FeedbackStats(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.FeedbackStatsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.FeedBackService.FeedbackStats(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListNotifications .
+// @router /notification/list [POST]
+func ListNotifications(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListNotificationsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.NotificationService.ListNotifications(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ReadNotifications .
+// @router /notification/read [POST]
+func ReadNotifications(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ReadNotificationsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.NotificationService.ReadNotifications(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ReadAllNotifications .
+// @router /notification/read_all [POST]
+func ReadAllNotifications(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ReadAllNotificationsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.NotificationService.ReadAllNotifications(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ExportUserData 导出个人数据, 成功时直接返回ZIP文件
+// @router /user/export [POST]
+func ExportUserData(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ExportUserDataReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	data, err := p.AccountService.ExportUserData(ctx, &req)
+	if err != nil {
+		adaptor.PostProcess(ctx, c, &req, nil, err)
+		return
+	}
+	c.Header("Content-Disposition", `attachment; filename="essay-show-data.zip"`)
+	c.Data(consts.StatusOK, "application/zip", data)
+}
+
+// DeleteAccount .
+// @router /user/delete [POST]
+func DeleteAccount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.DeleteAccountReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AccountService.DeleteAccount(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CancelDeleteAccount .
+// @router /user/cancel_delete [POST]
+func CancelDeleteAccount(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.CancelDeleteAccountReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.AccountService.CancelDeleteAccount(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreateLinkCode .
+// @router /relation/code [POST]
+func CreateLinkCode(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.CreateLinkCodeReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.RelationService.CreateLinkCode(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ConfirmLink .
+// @router /relation/confirm [POST]
+func ConfirmLink(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ConfirmLinkReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.RelationService.ConfirmLink(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListLinks .
+// @router /relation/list [POST]
+func ListLinks(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListLinksReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.RelationService.ListLinks(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RevokeLink .
+// @router /relation/revoke [POST]
+func RevokeLink(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.RevokeLinkReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.RelationService.RevokeLink(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetStudentStats .
+// @router /relation/stats [POST]
+func GetStudentStats(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetStudentStatsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.RelationService.GetStudentStats(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreateClass .
+// @router /class/create [POST]
+func CreateClass(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.CreateClassReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.CreateClass(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListClasses .
+// @router /class/list [POST]
+func ListClasses(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListClassesReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.ListClasses(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// JoinClass .
+// @router /class/join [POST]
+func JoinClass(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.JoinClassReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.JoinClass(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// LeaveClass .
+// @router /class/leave [POST]
+func LeaveClass(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.LeaveClassReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.LeaveClass(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListClassMembers .
+// @router /class/member/list [POST]
+func ListClassMembers(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListClassMembersReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.ListClassMembers(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// RemoveClassMember .
+// @router /class/member/remove [POST]
+func RemoveClassMember(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.RemoveClassMemberReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.RemoveClassMember(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreateAssignment .
+// @router /class/assignment/create [POST]
+func CreateAssignment(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.CreateAssignmentReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.CreateAssignment(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListAssignments .
+// @router /class/assignment/list [POST]
+func ListAssignments(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListAssignmentsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.ListAssignments(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetAssignmentReport .
+// @router /class/assignment/report [POST]
+func GetAssignmentReport(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetAssignmentReportReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ClassService.GetAssignmentReport(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// SaveReview .
+// @router /essay/review/save [POST]
+func SaveReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.SaveReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ReviewService.SaveReview(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetReview .
+// @router /essay/review/get [POST]
+func GetReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ReviewService.GetReview(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListReviewVersions .
+// @router /essay/review/history [POST]
+func ListReviewVersions(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListReviewVersionsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ReviewService.ListReviewVersions(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// DiffReview .
+// @router /essay/review/diff [POST]
+func DiffReview(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.DiffReviewReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ReviewService.DiffReview(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListPrompts .
+// @router /prompt/list [POST]
+func ListPrompts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListPromptsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.PromptService.ListPrompts(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// GetPrompt .
+// @router /prompt/get [POST]
+func GetPrompt(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.GetPromptReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.PromptService.GetPrompt(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// CreatePrompt .
+// @router /admin/prompt/create [POST]
+func CreatePrompt(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.CreatePromptReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.PromptService.CreatePrompt(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// UpdatePrompt .
+// @router /admin/prompt/update [POST]
+func UpdatePrompt(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.UpdatePromptReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.PromptService.UpdatePrompt(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// UpdatePromptStatus .
+// @router /admin/prompt/status [POST]
+func UpdatePromptStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.UpdatePromptStatusReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.PromptService.UpdatePromptStatus(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListAllPrompts .
+// @router /admin/prompt/list [POST]
+func ListAllPrompts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListPromptsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.PromptService.ListAllPrompts(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ListModerations .
+// @router /admin/moderation/list [POST]
+func ListModerations(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ListModerationsReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ModerationService.ListModerations(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
+
+// ResolveModeration .
+// @router /admin/moderation/resolve [POST]
+func ResolveModeration(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req show.ResolveModerationReq
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		c.String(consts.StatusBadRequest, err.Error())
+		return
+	}
+
+	p := provider.Get()
+	resp, err := p.ModerationService.ResolveModeration(ctx, &req)
+	adaptor.PostProcess(ctx, c, &req, resp, err)
+}
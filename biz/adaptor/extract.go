@@ -39,22 +39,11 @@ func ExtractUserMeta(ctx context.Context) (user *basic.UserMeta) {
 			log.CtxInfo(ctx, "extract user meta fail, err=%v", err)
 		}
 	}()
-	c, err := ExtractContext(ctx)
+	claims, err := parseToken(ctx)
 	if err != nil {
 		return
 	}
-	tokenString := c.GetHeader("Authorization")
-	token, err := jwt.Parse(string(tokenString), func(_ *jwt.Token) (interface{}, error) {
-		return jwt.ParseECPublicKeyFromPEM([]byte(config.GetConfig().Auth.PublicKey))
-	})
-	if err != nil {
-		return
-	}
-	if !token.Valid {
-		err = errors.New("token is not valid")
-		return
-	}
-	data, err := json.Marshal(token.Claims)
+	data, err := json.Marshal(claims)
 	if err != nil {
 		return
 	}
@@ -75,6 +64,39 @@ func ExtractUserMeta(ctx context.Context) (user *basic.UserMeta) {
 	return
 }
 
+// ExtractIssueTime 获取token的签发时间戳, token无效或没有签发时间时返回0
+func ExtractIssueTime(ctx context.Context) int64 {
+	claims, err := parseToken(ctx)
+	if err != nil {
+		return 0
+	}
+	iat, _ := claims["iat"].(float64)
+	return int64(iat)
+}
+
+// parseToken 校验并解析请求头中的token
+func parseToken(ctx context.Context) (jwt.MapClaims, error) {
+	c, err := ExtractContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokenString := c.GetHeader("Authorization")
+	token, err := jwt.Parse(string(tokenString), func(_ *jwt.Token) (interface{}, error) {
+		return jwt.ParseECPublicKeyFromPEM([]byte(config.GetConfig().Auth.PublicKey))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errors.New("token is not valid")
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("token claims is not valid")
+	}
+	return claims, nil
+}
+
 func ExtractExtra(ctx context.Context) (extra *basic.Extra) {
 	extra = new(basic.Extra)
 	var err error
@@ -0,0 +1,65 @@
+package adaptor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+)
+
+const roleKey = "role"
+
+// Policy 声明路由组允许访问的角色, 管理员可以访问所有路由
+type Policy []string
+
+var (
+	PolicyAdmin   = Policy{user.RoleAdmin}
+	PolicyTeacher = Policy{user.RoleTeacher}
+	PolicyParent  = Policy{user.RoleParent}
+)
+
+// RoleResolver 查询用户的角色
+type RoleResolver func(ctx context.Context, userId string) (string, error)
+
+// Allow 判断角色是否满足策略, 空角色视为学生
+func (p Policy) Allow(role string) bool {
+	if role == "" {
+		role = user.RoleStudent
+	}
+	return role == user.RoleAdmin || util.Contains(p, role)
+}
+
+// Require 返回按策略校验调用者角色的中间件, 校验通过后将角色写入请求上下文
+func (p Policy) Require(resolve RoleResolver) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		meta := ExtractUserMeta(ctx)
+		if meta.GetUserId() == "" {
+			PostProcess(ctx, c, nil, nil, consts.ErrNotAuthentication)
+			c.Abort()
+			return
+		}
+		role, err := resolve(ctx, meta.GetUserId())
+		if errors.Is(err, consts.ErrNotFound) || (err == nil && !p.Allow(role)) {
+			err = consts.ErrForbidden
+		}
+		if err != nil {
+			PostProcess(ctx, c, nil, nil, err)
+			c.Abort()
+			return
+		}
+		c.Set(roleKey, role)
+		c.Next(ctx)
+	}
+}
+
+// ExtractRole 获取经过策略校验的调用者角色, 路由未声明策略时返回空
+func ExtractRole(ctx context.Context) string {
+	c, err := ExtractContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return c.GetString(roleKey)
+}
@@ -0,0 +1,102 @@
+package adaptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+)
+
+// setUpAuth 加载配置并返回为用户签发token的函数
+func setUpAuth(t *testing.T) func(userId string) string {
+	return testutil.SignToken(t, testutil.LoadConfig(t))
+}
+
+func TestPolicyRequire(t *testing.T) {
+	sign := setUpAuth(t)
+	policies := []struct {
+		name   string
+		policy Policy
+	}{
+		{"admin", PolicyAdmin},
+		{"teacher", PolicyTeacher},
+		{"parent", PolicyParent},
+		{"default", Policy{}},
+	}
+	roles := []struct {
+		name string
+		role string
+		// 各策略下是否允许访问, 顺序与policies相同
+		allow [4]bool
+	}{
+		{"admin", user.RoleAdmin, [4]bool{true, true, true, true}},
+		{"teacher", user.RoleTeacher, [4]bool{false, true, false, false}},
+		{"parent", user.RoleParent, [4]bool{false, false, true, false}},
+		{"student", user.RoleStudent, [4]bool{false, false, false, false}},
+		{"missing role", "", [4]bool{false, false, false, false}},
+		{"unknown role", "superuser", [4]bool{false, false, false, false}},
+	}
+	for i, p := range policies {
+		for _, r := range roles {
+			t.Run(p.name+"/"+r.name, func(t *testing.T) {
+				c := app.NewContext(0)
+				c.Request.Header.Set("Authorization", sign("user"))
+				p.policy.Require(func(ctx context.Context, userId string) (string, error) {
+					if userId != "user" {
+						t.Errorf("resolve user %s, want user", userId)
+					}
+					return r.role, nil
+				})(InjectContext(context.Background(), c), c)
+
+				if c.IsAborted() == r.allow[i] {
+					t.Fatalf("aborted = %v, want allow %v", c.IsAborted(), r.allow[i])
+				}
+				if !r.allow[i] && c.Response.StatusCode() != 403 {
+					t.Errorf("status = %d, want 403", c.Response.StatusCode())
+				}
+				if r.allow[i] && c.GetString(roleKey) != r.role {
+					t.Errorf("role in context = %q, want %q", c.GetString(roleKey), r.role)
+				}
+			})
+		}
+	}
+}
+
+func TestPolicyRequireResolveError(t *testing.T) {
+	sign := setUpAuth(t)
+	cases := []struct {
+		name   string
+		token  string
+		err    error
+		status int
+	}{
+		{"not authenticated", "", nil, 200},
+		{"user not found", sign("user"), consts.ErrNotFound, 403},
+		{"resolve failed", sign("user"), errors.New("db down"), 500},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := app.NewContext(0)
+			if tc.token != "" {
+				c.Request.Header.Set("Authorization", tc.token)
+			}
+			PolicyTeacher.Require(func(ctx context.Context, userId string) (string, error) {
+				return user.RoleAdmin, tc.err
+			})(InjectContext(context.Background(), c), c)
+
+			if !c.IsAborted() {
+				t.Fatal("request should be aborted")
+			}
+			if c.Response.StatusCode() != tc.status {
+				t.Errorf("status = %d, want %d", c.Response.StatusCode(), tc.status)
+			}
+			if c.GetString(roleKey) != "" {
+				t.Errorf("role in context = %q, want empty", c.GetString(roleKey))
+			}
+		})
+	}
+}
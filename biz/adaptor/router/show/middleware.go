@@ -4,11 +4,14 @@ package show
 
 import (
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/provider"
 )
 
 func rootMw() []app.HandlerFunc {
-	// your code...
-	return nil
+	p := provider.Get()
+	return []app.HandlerFunc{adaptor.Session(p.AdminService.RevokeTime, p.AdminService.Status)}
 }
 
 func _essayMw() []app.HandlerFunc {
@@ -155,3 +158,471 @@ func _submitfeedbackMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _questionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reportquestionMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reportMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listquestionreportsMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyAdmin.Require(provider.Get().UserService.Role)}
+}
+
+func _deleteexerciseMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _regenerateexerciseMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _rankMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getrankMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updaterankprivacyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _achievementMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listachievementsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _makeupattendMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _quotaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getquotahistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adminMw() []app.HandlerFunc {
+	p := provider.Get()
+	return []app.HandlerFunc{adaptor.PolicyAdmin.Require(p.UserService.Role), adaptor.Audit(p.AdminService.Record)}
+}
+
+func _quota0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _auditquotaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _voucherMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createvoucherbatchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _voucher0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _redeemvoucherMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _planMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _grantplanMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _orderMw() []app.HandlerFunc {
+	return paymentMw()
+}
+
+func _listproductsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createorderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getorderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listordersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _order0Mw() []app.HandlerFunc {
+	return paymentMw()
+}
+
+// paymentMw 没有支付配置时关闭订单相关的接口
+func paymentMw() []app.HandlerFunc {
+	if config.GetConfig().Payment == nil {
+		return []app.HandlerFunc{adaptor.Disabled}
+	}
+	return nil
+}
+
+func _refundorderMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _paymentnotifyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listinviteesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _invitation0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listinvitationreviewsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _reviewinvitationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateinvitationcodeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _user0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _setuserroleMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _auditMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listauditlogsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchusersMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getuserdetailMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _adjustquotaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _banuserMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _forcelogoutMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _feedback0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listfeedbacksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _assignfeedbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatefeedbackstatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _replyfeedbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmyfeedbacksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getfeedbackMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _feedbackstatsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _notificationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listnotificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _readnotificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _readallnotificationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _exportuserdataMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deleteaccountMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _canceldeleteaccountMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _relationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createlinkcodeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _confirmlinkMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyParent.Require(provider.Get().UserService.Role)}
+}
+
+func _listlinksMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _revokelinkMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getstudentstatsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _classMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _memberMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _assignmentMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createclassMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyTeacher.Require(provider.Get().UserService.Role)}
+}
+
+func _listclassesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _joinclassMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _leaveclassMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listclassmembersMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyTeacher.Require(provider.Get().UserService.Role)}
+}
+
+func _removeclassmemberMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyTeacher.Require(provider.Get().UserService.Role)}
+}
+
+func _createassignmentMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyTeacher.Require(provider.Get().UserService.Role)}
+}
+
+func _listassignmentsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getassignmentreportMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyTeacher.Require(provider.Get().UserService.Role)}
+}
+
+func _reviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _savereviewMw() []app.HandlerFunc {
+	return []app.HandlerFunc{adaptor.PolicyTeacher.Require(provider.Get().UserService.Role)}
+}
+
+func _getreviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listreviewversionsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _diffreviewMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _promptMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _prompt0Mw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listpromptsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getpromptMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _createpromptMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatepromptMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updatepromptstatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listallpromptsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _moderationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listmoderationsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _resolvemoderationMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
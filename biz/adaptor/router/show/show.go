@@ -17,18 +17,114 @@ import (
 func Register(r *server.Hertz) {
 
 	root := r.Group("/", rootMw()...)
+	{
+		_achievement := root.Group("/achievement", _achievementMw()...)
+		_achievement.POST("/list", append(_listachievementsMw(), show.ListAchievements)...)
+	}
+	{
+		_admin := root.Group("/admin", _adminMw()...)
+		{
+			_audit := _admin.Group("/audit", _auditMw()...)
+			_audit.POST("/list", append(_listauditlogsMw(), show.ListAuditLogs)...)
+		}
+		{
+			_feedback := _admin.Group("/feedback", _feedback0Mw()...)
+			_feedback.POST("/assign", append(_assignfeedbackMw(), show.AssignFeedback)...)
+			_feedback.POST("/get", append(_getfeedbackMw(), show.GetFeedback)...)
+			_feedback.POST("/list", append(_listfeedbacksMw(), show.ListFeedbacks)...)
+			_feedback.POST("/reply", append(_replyfeedbackMw(), show.ReplyFeedback)...)
+			_feedback.POST("/stats", append(_feedbackstatsMw(), show.FeedbackStats)...)
+			_feedback.POST("/status", append(_updatefeedbackstatusMw(), show.UpdateFeedbackStatus)...)
+		}
+		{
+			_invitation := _admin.Group("/invitation", _invitation0Mw()...)
+			_invitation.POST("/list", append(_listinvitationreviewsMw(), show.ListInvitationReviews)...)
+			_invitation.POST("/review", append(_reviewinvitationMw(), show.ReviewInvitation)...)
+			_invitation.POST("/update", append(_updateinvitationcodeMw(), show.UpdateInvitationCode)...)
+		}
+		{
+			_moderation := _admin.Group("/moderation", _moderationMw()...)
+			_moderation.POST("/list", append(_listmoderationsMw(), show.ListModerations)...)
+			_moderation.POST("/resolve", append(_resolvemoderationMw(), show.ResolveModeration)...)
+		}
+		{
+			_order := _admin.Group("/order", _order0Mw()...)
+			_order.POST("/refund", append(_refundorderMw(), show.RefundOrder)...)
+		}
+		{
+			_plan := _admin.Group("/plan", _planMw()...)
+			_plan.POST("/grant", append(_grantplanMw(), show.GrantPlan)...)
+		}
+		{
+			_prompt := _admin.Group("/prompt", _prompt0Mw()...)
+			_prompt.POST("/create", append(_createpromptMw(), show.CreatePrompt)...)
+			_prompt.POST("/list", append(_listallpromptsMw(), show.ListAllPrompts)...)
+			_prompt.POST("/status", append(_updatepromptstatusMw(), show.UpdatePromptStatus)...)
+			_prompt.POST("/update", append(_updatepromptMw(), show.UpdatePrompt)...)
+		}
+		{
+			_quota := _admin.Group("/quota", _quota0Mw()...)
+			_quota.POST("/audit", append(_auditquotaMw(), show.AuditQuota)...)
+		}
+		{
+			_user := _admin.Group("/user", _user0Mw()...)
+			_user.POST("/ban", append(_banuserMw(), show.BanUser)...)
+			_user.POST("/detail", append(_getuserdetailMw(), show.GetUserDetail)...)
+			_user.POST("/logout", append(_forcelogoutMw(), show.ForceLogout)...)
+			_user.POST("/quota", append(_adjustquotaMw(), show.AdjustQuota)...)
+			_user.POST("/role", append(_setuserroleMw(), show.SetUserRole)...)
+			_user.POST("/search", append(_searchusersMw(), show.SearchUsers)...)
+		}
+		{
+			_voucher := _admin.Group("/voucher", _voucherMw()...)
+			_voucher.POST("/create", append(_createvoucherbatchMw(), show.CreateVoucherBatch)...)
+		}
+	}
+	{
+		_class := root.Group("/class", _classMw()...)
+		_class.POST("/create", append(_createclassMw(), show.CreateClass)...)
+		_class.POST("/join", append(_joinclassMw(), show.JoinClass)...)
+		_class.POST("/leave", append(_leaveclassMw(), show.LeaveClass)...)
+		_class.POST("/list", append(_listclassesMw(), show.ListClasses)...)
+		{
+			_assignment := _class.Group("/assignment", _assignmentMw()...)
+			_assignment.POST("/create", append(_createassignmentMw(), show.CreateAssignment)...)
+			_assignment.POST("/list", append(_listassignmentsMw(), show.ListAssignments)...)
+			_assignment.POST("/report", append(_getassignmentreportMw(), show.GetAssignmentReport)...)
+		}
+		{
+			_member := _class.Group("/member", _memberMw()...)
+			_member.POST("/list", append(_listclassmembersMw(), show.ListClassMembers)...)
+			_member.POST("/remove", append(_removeclassmemberMw(), show.RemoveClassMember)...)
+		}
+	}
 	{
 		_essay := root.Group("/essay", _essayMw()...)
 		_essay.POST("/evaluate", append(_essayevaluateMw(), show.EssayEvaluate)...)
 		_essay.POST("/like", append(_likeevaluateMw(), show.LikeEvaluate)...)
 		_essay.POST("/logs", append(_getevaluatelogsMw(), show.GetEvaluateLogs)...)
+		{
+			_review := _essay.Group("/review", _reviewMw()...)
+			_review.POST("/diff", append(_diffreviewMw(), show.DiffReview)...)
+			_review.POST("/get", append(_getreviewMw(), show.GetReview)...)
+			_review.POST("/history", append(_listreviewversionsMw(), show.ListReviewVersions)...)
+			_review.POST("/save", append(_savereviewMw(), show.SaveReview)...)
+		}
 	}
 	{
 		_exercise := root.Group("/exercise", _exerciseMw()...)
 		_exercise.POST("/create", append(_createexerciseMw(), show.CreateExercise)...)
+		_exercise.POST("/delete", append(_deleteexerciseMw(), show.DeleteExercise)...)
 		_exercise.POST("/do", append(_doexerciseMw(), show.DoExercise)...)
 		_exercise.POST("/get", append(_getexerciseMw(), show.GetExercise)...)
 		_exercise.POST("/like", append(_likeexerciseMw(), show.LikeExercise)...)
+		_exercise.POST("/regenerate", append(_regenerateexerciseMw(), show.RegenerateExercise)...)
+		{
+			_question := _exercise.Group("/question", _questionMw()...)
+			_question.POST("/report", append(_reportquestionMw(), show.ReportQuestion)...)
+			_report := _question.Group("/report", _reportMw()...)
+			_report.POST("/list", append(_listquestionreportsMw(), show.ListQuestionReports)...)
+		}
 		{
 			_simple := _exercise.Group("/simple", _simpleMw()...)
 			_simple.POST("/list", append(_listsimpleexercisesMw(), show.ListSimpleExercises)...)
@@ -36,8 +132,41 @@ func Register(r *server.Hertz) {
 	}
 	{
 		_feedback := root.Group("/feedback", _feedbackMw()...)
+		_feedback.POST("/list", append(_listmyfeedbacksMw(), show.ListMyFeedbacks)...)
 		_feedback.POST("/submit", append(_submitfeedbackMw(), show.SubmitFeedback)...)
 	}
+	{
+		_notification := root.Group("/notification", _notificationMw()...)
+		_notification.POST("/list", append(_listnotificationsMw(), show.ListNotifications)...)
+		_notification.POST("/read", append(_readnotificationsMw(), show.ReadNotifications)...)
+		_notification.POST("/read_all", append(_readallnotificationsMw(), show.ReadAllNotifications)...)
+	}
+	{
+		_order := root.Group("/order", _orderMw()...)
+		_order.POST("/create", append(_createorderMw(), show.CreateOrder)...)
+		_order.POST("/get", append(_getorderMw(), show.GetOrder)...)
+		_order.POST("/list", append(_listordersMw(), show.ListOrders)...)
+		_order.POST("/notify", append(_paymentnotifyMw(), show.PaymentNotify)...)
+		_order.POST("/products", append(_listproductsMw(), show.ListProducts)...)
+	}
+	{
+		_prompt := root.Group("/prompt", _promptMw()...)
+		_prompt.POST("/get", append(_getpromptMw(), show.GetPrompt)...)
+		_prompt.POST("/list", append(_listpromptsMw(), show.ListPrompts)...)
+	}
+	{
+		_rank := root.Group("/rank", _rankMw()...)
+		_rank.POST("/get", append(_getrankMw(), show.GetRank)...)
+		_rank.POST("/privacy", append(_updaterankprivacyMw(), show.UpdateRankPrivacy)...)
+	}
+	{
+		_relation := root.Group("/relation", _relationMw()...)
+		_relation.POST("/code", append(_createlinkcodeMw(), show.CreateLinkCode)...)
+		_relation.POST("/confirm", append(_confirmlinkMw(), show.ConfirmLink)...)
+		_relation.POST("/list", append(_listlinksMw(), show.ListLinks)...)
+		_relation.POST("/revoke", append(_revokelinkMw(), show.RevokeLink)...)
+		_relation.POST("/stats", append(_getstudentstatsMw(), show.GetStudentStats)...)
+	}
 	{
 		_sts := root.Group("/sts", _stsMw()...)
 		_sts.POST("/apply", append(_applysignedurlMw(), show.ApplySignedUrl)...)
@@ -46,9 +175,13 @@ func Register(r *server.Hertz) {
 	}
 	{
 		_user := root.Group("/user", _userMw()...)
+		_user.POST("/cancel_delete", append(_canceldeleteaccountMw(), show.CancelDeleteAccount)...)
 		_user.GET("/daily_attend", append(_dailyattendMw(), show.DailyAttend)...)
 		_daily_attend := _user.Group("/daily_attend", _daily_attendMw()...)
 		_daily_attend.GET("/get", append(_getdailyattendMw(), show.GetDailyAttend)...)
+		_daily_attend.POST("/make_up", append(_makeupattendMw(), show.MakeUpAttend)...)
+		_user.POST("/delete", append(_deleteaccountMw(), show.DeleteAccount)...)
+		_user.POST("/export", append(_exportuserdataMw(), show.ExportUserData)...)
 		_user.GET("/info", append(_getuserinfoMw(), show.GetUserInfo)...)
 		_user.POST("/sign_in", append(_signinMw(), show.SignIn)...)
 		_user.POST("/sign_up", append(_signupMw(), show.SignUp)...)
@@ -58,6 +191,15 @@ func Register(r *server.Hertz) {
 			_invitation := _user.Group("/invitation", _invitationMw()...)
 			_invitation.GET("/code", append(_getinvitationcodeMw(), show.GetInvitationCode)...)
 			_invitation.POST("/fill", append(_fillinvitationcodeMw(), show.FillInvitationCode)...)
+			_invitation.POST("/list", append(_listinviteesMw(), show.ListInvitees)...)
+		}
+		{
+			_quota := _user.Group("/quota", _quotaMw()...)
+			_quota.POST("/history", append(_getquotahistoryMw(), show.GetQuotaHistory)...)
+		}
+		{
+			_voucher := _user.Group("/voucher", _voucher0Mw()...)
+			_voucher.POST("/redeem", append(_redeemvoucherMw(), show.RedeemVoucher)...)
 		}
 	}
 }
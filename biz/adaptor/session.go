@@ -0,0 +1,108 @@
+package adaptor
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+)
+
+// RevokeTimeResolver 查询用户被强制下线的时间戳, 没有被强制下线时返回0
+type RevokeTimeResolver func(ctx context.Context, userId string) (int64, error)
+
+// StatusResolver 查询用户的账号状态
+type StatusResolver func(ctx context.Context, userId string) (int, error)
+
+// AuditRecorder 记录一次管理员操作
+type AuditRecorder func(ctx context.Context, adminId, action, request string, status int)
+
+// Session 返回拒绝强制下线前签发的token以及已封禁、已注销用户的中间件, 未登录的请求直接放行
+func Session(revokeTime RevokeTimeResolver, status StatusResolver) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userId := ExtractUserMeta(ctx).GetUserId()
+		if userId == "" {
+			c.Next(ctx)
+			return
+		}
+		t, err := revokeTime(ctx, userId)
+		if err != nil {
+			// 查询失败时放行, 不影响正常用户
+			log.CtxError(ctx, "session: revoke time of %s error %v", userId, err)
+		} else if t > 0 && ExtractIssueTime(ctx) <= t {
+			PostProcess(ctx, c, nil, nil, consts.ErrNotAuthentication)
+			c.Abort()
+			return
+		}
+		// 强制下线失败时封禁仍然生效
+		s, err := status(ctx, userId)
+		if err != nil {
+			log.CtxError(ctx, "session: status of %s error %v", userId, err)
+		} else if s == consts.BanStatus || s == consts.DeleteStatus {
+			err = consts.ErrUserBanned
+			if s == consts.DeleteStatus {
+				err = consts.ErrUserDeleted
+			}
+			PostProcess(ctx, c, nil, nil, err)
+			c.Abort()
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// Audit 返回在请求处理完成后记录管理员操作的中间件, 需放在管理员策略之后, 只记录管理员的请求
+// 请求中的密码、手机号等敏感字段脱敏后记录
+func Audit(record AuditRecorder) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		c.Next(ctx)
+		record(ctx, ExtractUserMeta(ctx).GetUserId(), string(c.Path()), redact(c.Request.Body()), c.Response.StatusCode())
+	}
+}
+
+// sensitiveKeys 审计日志中需要脱敏的字段, 按字段名小写后包含匹配
+var sensitiveKeys = []string{"password", "token", "secret", "phone", "verifycode", "linkcode"}
+
+// redact 将JSON请求体中的敏感字段替换为***, 不是JSON时只记录长度
+func redact(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "<" + strconv.Itoa(len(body)) + " bytes>"
+	}
+	b, _ := json.Marshal(redactValue(v))
+	return string(b)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if sensitive(k) {
+				v[k] = "***"
+			} else {
+				v[k] = redactValue(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	}
+	return v
+}
+
+func sensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package adaptor
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		body, want string
+	}{
+		{``, ``},
+		{`{"userId":"u1","ban":true}`, `{"ban":true,"userId":"u1"}`},
+		{`{"phone":"13800000000","user":{"password":"p","name":"n"}}`, `{"phone":"***","user":{"name":"n","password":"***"}}`},
+		{`[{"verifyCode":"1234"}]`, `[{"verifyCode":"***"}]`},
+		{`phone=13800000000`, `<17 bytes>`},
+	}
+	for _, c := range cases {
+		if got := redact([]byte(c.body)); got != c.want {
+			t.Errorf("redact(%s) = %s, want %s", c.body, got, c.want)
+		}
+	}
+}
@@ -27,7 +27,7 @@ var file_show_proto_rawDesc = []byte{
 	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x1a, 0x17, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2f,
 	0x73, 0x68, 0x6f, 0x77, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x0f, 0x68, 0x74, 0x74, 0x70, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0xe3, 0x0b, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x4a, 0x0a, 0x06, 0x53,
+	0x74, 0x6f, 0x32, 0xa0, 0x3d, 0x0a, 0x04, 0x73, 0x68, 0x6f, 0x77, 0x12, 0x4a, 0x0a, 0x06, 0x53,
 	0x69, 0x67, 0x6e, 0x55, 0x70, 0x12, 0x15, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
 	0x6f, 0x77, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x65,
 	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x55, 0x70,
@@ -67,187 +67,855 @@ var file_show_proto_rawDesc = []byte{
 	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x41,
 	0x74, 0x74, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xca, 0xc1, 0x18, 0x16, 0x2f,
 	0x75, 0x73, 0x65, 0x72, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6e,
-	0x64, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x73, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e, 0x65, 0x73, 0x73,
-	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x65,
-	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x19, 0xca, 0xc1, 0x18, 0x15, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x68, 0x0a, 0x12, 0x46, 0x69,
-	0x6c, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x21, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x46, 0x69,
-	0x6c, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x66, 0x69, 0x6c, 0x6c, 0x12, 0x61, 0x0a, 0x0d, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
-	0x6f, 0x77, 0x2e, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x2e, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x13, 0xd2, 0xc1, 0x18, 0x0f, 0x2f, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2f, 0x65,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x45,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
-	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
-	0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xd2, 0xc1, 0x18, 0x0b,
-	0x2f, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x6d, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x23,
-	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x45,
-	0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73,
-	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0xd2, 0xc1, 0x18, 0x0b, 0x2f,
-	0x65, 0x73, 0x73, 0x61, 0x79, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x03, 0x4f, 0x43,
-	0x52, 0x12, 0x12, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4f,
-	0x43, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
-	0x6f, 0x77, 0x2e, 0x4f, 0x43, 0x52, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0c, 0xd2, 0xc1, 0x18, 0x08,
-	0x2f, 0x73, 0x74, 0x73, 0x2f, 0x6f, 0x63, 0x72, 0x12, 0x5f, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x73,
-	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x65, 0x73, 0x73, 0x61,
-	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0e, 0xd2, 0xc1, 0x18, 0x0a, 0x2f,
-	0x73, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x60, 0x0a, 0x0e, 0x53, 0x65, 0x6e,
-	0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x73,
-	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73,
+	0x64, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x61, 0x0a, 0x0c, 0x4d, 0x61, 0x6b, 0x65, 0x55, 0x70, 0x41,
+	0x74, 0x74, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x55, 0x70, 0x41, 0x74, 0x74, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0xd2, 0xc1, 0x18, 0x1a, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x64,
+	0x2f, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x75, 0x70, 0x12, 0x73, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x21, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x19, 0xca, 0xc1, 0x18, 0x15, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x68, 0x0a,
+	0x12, 0x46, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x2e, 0x46, 0x69, 0x6c, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0xd2, 0xc1,
+	0x18, 0x15, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x61, 0x0a, 0x0d, 0x45, 0x73, 0x73, 0x61, 0x79,
+	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xd2, 0xc1, 0x18, 0x0f, 0x2f, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x4c, 0x69,
+	0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0xd2,
+	0xc1, 0x18, 0x0b, 0x2f, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x6d,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x73, 0x73, 0x61, 0x79, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0xd2, 0xc1,
+	0x18, 0x0b, 0x2f, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x3c, 0x0a,
+	0x03, 0x4f, 0x43, 0x52, 0x12, 0x12, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x2e, 0x4f, 0x43, 0x52, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4f, 0x43, 0x52, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0c, 0xd2,
+	0xc1, 0x18, 0x08, 0x2f, 0x73, 0x74, 0x73, 0x2f, 0x6f, 0x63, 0x72, 0x12, 0x5f, 0x0a, 0x0e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x72, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0e, 0xd2, 0xc1,
+	0x18, 0x0a, 0x2f, 0x73, 0x74, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x60, 0x0a, 0x0e,
+	0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x73, 0x74, 0x73, 0x2f, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x5b,
+	0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x12, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a,
+	0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x07, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x16, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0d, 0xd2, 0xc1, 0x18, 0x09, 0x2f, 0x72, 0x61,
+	0x6e, 0x6b, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x5e, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x6e, 0x6b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x20, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x6e, 0x6b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x11, 0xd2, 0xc1, 0x18, 0x0d, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x2f, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x12, 0x6c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63,
+	0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x68, 0x69,
+	0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x68,
+	0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xd2,
+	0xc1, 0x18, 0x11, 0x2f, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x17, 0xd2, 0xc1, 0x18, 0x13, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x5b, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12,
+	0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x76,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x21, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18,
+	0x15, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x2f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
+	0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x12, 0x52,
+	0x0a, 0x09, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x18, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0xd2, 0xc1, 0x18,
+	0x11, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x2f, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xd2,
+	0xc1, 0x18, 0x0f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1, 0x18, 0x0d,
+	0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x4d, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x18, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0e, 0xd2, 0xc1,
+	0x18, 0x0a, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x54, 0x0a, 0x0a,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x19, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x0f, 0xd2, 0xc1, 0x18, 0x0b, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x58, 0x0a, 0x0b, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x17, 0xd2, 0xc1, 0x18, 0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x64, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x75, 0x73,
+	0x65, 0x72, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x24, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x67, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0xd2, 0xc1, 0x18, 0x18, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x6f,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1c, 0xd2, 0xc1, 0x18, 0x18, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x55, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1a,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73,
 	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x73, 0x74, 0x73, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x5f,
-	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1d, 0x2e,
-	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65,
-	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
-	0x6b, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x32, 0xf5, 0x03, 0x0a, 0x08, 0x65, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
-	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63,
-	0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x65, 0x78, 0x65,
-	0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69,
-	0x73, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63,
-	0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
-	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x45,
-	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1,
-	0x18, 0x15, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52,
+	0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x5e, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16,
+	0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x64, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x5d, 0x0a, 0x0b,
+	0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x4c, 0x0a, 0x07, 0x42,
+	0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x42, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0xd2, 0xc1, 0x18, 0x0f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x6e, 0x12, 0x57, 0x0a, 0x0b, 0x46, 0x6f, 0x72,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12,
+	0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x6c, 0x6f, 0x67, 0x6f,
+	0x75, 0x74, 0x12, 0x63, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x79, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f,
+	0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x66, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1c,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18,
+	0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x46,
+	0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0xd2, 0xc1,
+	0x18, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0xd2, 0xc1, 0x18,
+	0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5e, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x46, 0x65, 0x65, 0x64, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0xd2, 0xc1,
+	0x18, 0x15, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63,
+	0x6b, 0x2f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x65,
+	0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52,
 	0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
-	0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x11, 0xd2, 0xc1, 0x18, 0x0d, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x67,
-	0x65, 0x74, 0x12, 0x55, 0x0a, 0x0a, 0x44, 0x6f, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
-	0x12, 0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x6f,
-	0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x65, 0x73,
-	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x6f, 0x45, 0x78, 0x65, 0x72, 0x63,
-	0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10, 0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x65, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x64, 0x6f, 0x12, 0x55, 0x0a, 0x0c, 0x4c, 0x69, 0x6b,
-	0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61,
-	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63,
-	0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0xd2, 0xc1,
-	0x18, 0x0e, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x6b, 0x65,
-	0x42, 0x6f, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e, 0x78, 0x68, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69,
-	0x73, 0x2e, 0x69, 0x64, 0x6c, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
-	0x68, 0x6f, 0x77, 0x42, 0x09, 0x53, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x68, 0x2d,
-	0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2d, 0x73, 0x68,
-	0x6f, 0x77, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2f, 0x73, 0x68, 0x6f,
-	0x77, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x17, 0xd2, 0xc1, 0x18, 0x13, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x67, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x64,
+	0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x62, 0x61, 0x63, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x70, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18,
+	0x12, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x63, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x6d, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64,
+	0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0xd2, 0xc1, 0x18,
+	0x16, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x12, 0x57, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10,
+	0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x5e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10,
+	0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x68, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0xd2, 0xc1, 0x18, 0x13, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x2f, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x63, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0xd2, 0xc1, 0x18,
+	0x0e, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x5d, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1a,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x54,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x53, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x67, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x13, 0xd2,
+	0xc1, 0x18, 0x0f, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1, 0x18, 0x0d,
+	0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0xd2, 0xc1, 0x18, 0x0b, 0x2f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x18, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0xd2, 0xc1, 0x18, 0x0b, 0x2f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x4f, 0x0a, 0x0a, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x6d, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x20, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x2f, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x11, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x12, 0x73, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xd2, 0xc1, 0x18, 0x18, 0x2f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x6e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2, 0xc1, 0x18, 0x16, 0x2f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x7c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1c, 0xd2, 0xc1, 0x18, 0x18, 0x2f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x2f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x5b, 0x0a, 0x0a, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x73, 0x61, 0x76, 0x65,
+	0x12, 0x57, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x18, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e,
+	0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x15, 0xd2, 0xc1, 0x18, 0x11, 0x2f, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2f, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x76, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x21, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x5b, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x12, 0x58,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x10, 0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x70, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68,
+	0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0xd2, 0xc1, 0x18, 0x0b,
+	0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x2f, 0x67, 0x65, 0x74, 0x12, 0x63, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x1b, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x5b, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x12, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x18, 0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x67, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x21, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0xd2, 0xc1,
+	0x18, 0x14, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x61, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x16, 0xd2, 0xc1, 0x18, 0x12, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6e, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1a, 0xd2,
+	0xc1, 0x18, 0x16, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x6a, 0x0a, 0x11, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0xd2, 0xc1, 0x18, 0x19, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x32, 0xb0, 0x07, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x12, 0x65, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x79, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73,
+	0x12, 0x22, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f,
+	0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x19, 0xd2, 0xc1, 0x18, 0x15, 0x2f,
+	0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x12, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0xd2, 0xc1,
+	0x18, 0x0d, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x12,
+	0x55, 0x0a, 0x0a, 0x44, 0x6f, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x19, 0x2e,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x6f, 0x45, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79,
+	0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x6f, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x10, 0xd2, 0xc1, 0x18, 0x0c, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x2f, 0x64, 0x6f, 0x12, 0x55, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x78,
+	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73,
+	0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0xd2, 0xc1, 0x18, 0x0e, 0x2f,
+	0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x64, 0x0a,
+	0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0xd2, 0xc1, 0x18, 0x19, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x82, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x23, 0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x22, 0xd2, 0xc1, 0x18, 0x1e, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x73, 0x73,
+	0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x78,
+	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x65, 0x73, 0x73, 0x61,
+	0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x14, 0xd2, 0xc1, 0x18, 0x10, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x73,
+	0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18,
+	0xd2, 0xc1, 0x18, 0x14, 0x2f, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x2f, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x0a, 0x1f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x78, 0x68, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2e, 0x69, 0x64, 0x6c, 0x67, 0x65, 0x6e,
+	0x2e, 0x65, 0x73, 0x73, 0x61, 0x79, 0x2e, 0x73, 0x68, 0x6f, 0x77, 0x42, 0x09, 0x53, 0x68, 0x6f,
+	0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x68, 0x2d, 0x70, 0x6f, 0x6c, 0x61, 0x72, 0x69, 0x73, 0x2f,
+	0x65, 0x73, 0x73, 0x61, 0x79, 0x2d, 0x73, 0x68, 0x6f, 0x77, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x64, 0x74, 0x6f, 0x2f, 0x65,
+	0x73, 0x73, 0x61, 0x79, 0x2f, 0x73, 0x68, 0x6f, 0x77, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var file_show_proto_goTypes = []interface{}{
-	(*SignUpReq)(nil),                // 0: essay.show.SignUpReq
-	(*SignInReq)(nil),                // 1: essay.show.SignInReq
-	(*GetUserInfoReq)(nil),           // 2: essay.show.GetUserInfoReq
-	(*UpdatePasswordReq)(nil),        // 3: essay.show.UpdatePasswordReq
-	(*UpdateUserInfoReq)(nil),        // 4: essay.show.UpdateUserInfoReq
-	(*DailyAttendReq)(nil),           // 5: essay.show.DailyAttendReq
-	(*GetDailyAttendReq)(nil),        // 6: essay.show.GetDailyAttendReq
-	(*GetInvitationCodeReq)(nil),     // 7: essay.show.GetInvitationCodeReq
-	(*FillInvitationCodeReq)(nil),    // 8: essay.show.FillInvitationCodeReq
-	(*EssayEvaluateReq)(nil),         // 9: essay.show.EssayEvaluateReq
-	(*LikeEvaluateReq)(nil),          // 10: essay.show.LikeEvaluateReq
-	(*GetEssayEvaluateLogsReq)(nil),  // 11: essay.show.GetEssayEvaluateLogsReq
-	(*OCRReq)(nil),                   // 12: essay.show.OCRReq
-	(*ApplySignedUrlReq)(nil),        // 13: essay.show.ApplySignedUrlReq
-	(*SendVerifyCodeReq)(nil),        // 14: essay.show.SendVerifyCodeReq
-	(*SubmitFeedbackReq)(nil),        // 15: essay.show.SubmitFeedbackReq
-	(*CreateExerciseReq)(nil),        // 16: essay.show.CreateExerciseReq
-	(*ListSimpleExercisesReq)(nil),   // 17: essay.show.ListSimpleExercisesReq
-	(*GetExerciseReq)(nil),           // 18: essay.show.GetExerciseReq
-	(*DoExerciseReq)(nil),            // 19: essay.show.DoExerciseReq
-	(*LikeExerciseReq)(nil),          // 20: essay.show.LikeExerciseReq
-	(*SignUpResp)(nil),               // 21: essay.show.SignUpResp
-	(*SignInResp)(nil),               // 22: essay.show.SignInResp
-	(*GetUserInfoResp)(nil),          // 23: essay.show.GetUserInfoResp
-	(*Response)(nil),                 // 24: essay.show.Response
-	(*GetDailyAttendResp)(nil),       // 25: essay.show.GetDailyAttendResp
-	(*GetInvitationCodeResp)(nil),    // 26: essay.show.GetInvitationCodeResp
-	(*EssayEvaluateResp)(nil),        // 27: essay.show.EssayEvaluateResp
-	(*GetEssayEvaluateLogsResp)(nil), // 28: essay.show.GetEssayEvaluateLogsResp
-	(*OCRResp)(nil),                  // 29: essay.show.OCRResp
-	(*ApplySignedUrlResp)(nil),       // 30: essay.show.ApplySignedUrlResp
-	(*CreateExerciseResp)(nil),       // 31: essay.show.CreateExerciseResp
-	(*ListSimpleExercisesResp)(nil),  // 32: essay.show.ListSimpleExercisesResp
-	(*GetExerciseResp)(nil),          // 33: essay.show.GetExerciseResp
-	(*DoExerciseResp)(nil),           // 34: essay.show.DoExerciseResp
+	(*SignUpReq)(nil),                 // 0: essay.show.SignUpReq
+	(*SignInReq)(nil),                 // 1: essay.show.SignInReq
+	(*GetUserInfoReq)(nil),            // 2: essay.show.GetUserInfoReq
+	(*UpdatePasswordReq)(nil),         // 3: essay.show.UpdatePasswordReq
+	(*UpdateUserInfoReq)(nil),         // 4: essay.show.UpdateUserInfoReq
+	(*DailyAttendReq)(nil),            // 5: essay.show.DailyAttendReq
+	(*GetDailyAttendReq)(nil),         // 6: essay.show.GetDailyAttendReq
+	(*MakeUpAttendReq)(nil),           // 7: essay.show.MakeUpAttendReq
+	(*GetInvitationCodeReq)(nil),      // 8: essay.show.GetInvitationCodeReq
+	(*FillInvitationCodeReq)(nil),     // 9: essay.show.FillInvitationCodeReq
+	(*EssayEvaluateReq)(nil),          // 10: essay.show.EssayEvaluateReq
+	(*LikeEvaluateReq)(nil),           // 11: essay.show.LikeEvaluateReq
+	(*GetEssayEvaluateLogsReq)(nil),   // 12: essay.show.GetEssayEvaluateLogsReq
+	(*OCRReq)(nil),                    // 13: essay.show.OCRReq
+	(*ApplySignedUrlReq)(nil),         // 14: essay.show.ApplySignedUrlReq
+	(*SendVerifyCodeReq)(nil),         // 15: essay.show.SendVerifyCodeReq
+	(*SubmitFeedbackReq)(nil),         // 16: essay.show.SubmitFeedbackReq
+	(*GetRankReq)(nil),                // 17: essay.show.GetRankReq
+	(*UpdateRankPrivacyReq)(nil),      // 18: essay.show.UpdateRankPrivacyReq
+	(*ListAchievementsReq)(nil),       // 19: essay.show.ListAchievementsReq
+	(*GetQuotaHistoryReq)(nil),        // 20: essay.show.GetQuotaHistoryReq
+	(*AuditQuotaReq)(nil),             // 21: essay.show.AuditQuotaReq
+	(*CreateVoucherBatchReq)(nil),     // 22: essay.show.CreateVoucherBatchReq
+	(*RedeemVoucherReq)(nil),          // 23: essay.show.RedeemVoucherReq
+	(*GrantPlanReq)(nil),              // 24: essay.show.GrantPlanReq
+	(*ListProductsReq)(nil),           // 25: essay.show.ListProductsReq
+	(*CreateOrderReq)(nil),            // 26: essay.show.CreateOrderReq
+	(*GetOrderReq)(nil),               // 27: essay.show.GetOrderReq
+	(*ListOrdersReq)(nil),             // 28: essay.show.ListOrdersReq
+	(*RefundOrderReq)(nil),            // 29: essay.show.RefundOrderReq
+	(*ListInviteesReq)(nil),           // 30: essay.show.ListInviteesReq
+	(*ListInvitationReviewsReq)(nil),  // 31: essay.show.ListInvitationReviewsReq
+	(*ReviewInvitationReq)(nil),       // 32: essay.show.ReviewInvitationReq
+	(*UpdateInvitationCodeReq)(nil),   // 33: essay.show.UpdateInvitationCodeReq
+	(*SetUserRoleReq)(nil),            // 34: essay.show.SetUserRoleReq
+	(*SearchUsersReq)(nil),            // 35: essay.show.SearchUsersReq
+	(*GetUserDetailReq)(nil),          // 36: essay.show.GetUserDetailReq
+	(*AdjustQuotaReq)(nil),            // 37: essay.show.AdjustQuotaReq
+	(*BanUserReq)(nil),                // 38: essay.show.BanUserReq
+	(*ForceLogoutReq)(nil),            // 39: essay.show.ForceLogoutReq
+	(*ListAuditLogsReq)(nil),          // 40: essay.show.ListAuditLogsReq
+	(*ListMyFeedbacksReq)(nil),        // 41: essay.show.ListMyFeedbacksReq
+	(*ListFeedbacksReq)(nil),          // 42: essay.show.ListFeedbacksReq
+	(*AssignFeedbackReq)(nil),         // 43: essay.show.AssignFeedbackReq
+	(*UpdateFeedbackStatusReq)(nil),   // 44: essay.show.UpdateFeedbackStatusReq
+	(*ReplyFeedbackReq)(nil),          // 45: essay.show.ReplyFeedbackReq
+	(*GetFeedbackReq)(nil),            // 46: essay.show.GetFeedbackReq
+	(*FeedbackStatsReq)(nil),          // 47: essay.show.FeedbackStatsReq
+	(*ListNotificationsReq)(nil),      // 48: essay.show.ListNotificationsReq
+	(*ReadNotificationsReq)(nil),      // 49: essay.show.ReadNotificationsReq
+	(*ReadAllNotificationsReq)(nil),   // 50: essay.show.ReadAllNotificationsReq
+	(*ExportUserDataReq)(nil),         // 51: essay.show.ExportUserDataReq
+	(*DeleteAccountReq)(nil),          // 52: essay.show.DeleteAccountReq
+	(*CancelDeleteAccountReq)(nil),    // 53: essay.show.CancelDeleteAccountReq
+	(*CreateLinkCodeReq)(nil),         // 54: essay.show.CreateLinkCodeReq
+	(*ConfirmLinkReq)(nil),            // 55: essay.show.ConfirmLinkReq
+	(*ListLinksReq)(nil),              // 56: essay.show.ListLinksReq
+	(*RevokeLinkReq)(nil),             // 57: essay.show.RevokeLinkReq
+	(*GetStudentStatsReq)(nil),        // 58: essay.show.GetStudentStatsReq
+	(*CreateClassReq)(nil),            // 59: essay.show.CreateClassReq
+	(*ListClassesReq)(nil),            // 60: essay.show.ListClassesReq
+	(*JoinClassReq)(nil),              // 61: essay.show.JoinClassReq
+	(*LeaveClassReq)(nil),             // 62: essay.show.LeaveClassReq
+	(*ListClassMembersReq)(nil),       // 63: essay.show.ListClassMembersReq
+	(*RemoveClassMemberReq)(nil),      // 64: essay.show.RemoveClassMemberReq
+	(*CreateAssignmentReq)(nil),       // 65: essay.show.CreateAssignmentReq
+	(*ListAssignmentsReq)(nil),        // 66: essay.show.ListAssignmentsReq
+	(*GetAssignmentReportReq)(nil),    // 67: essay.show.GetAssignmentReportReq
+	(*SaveReviewReq)(nil),             // 68: essay.show.SaveReviewReq
+	(*GetReviewReq)(nil),              // 69: essay.show.GetReviewReq
+	(*ListReviewVersionsReq)(nil),     // 70: essay.show.ListReviewVersionsReq
+	(*DiffReviewReq)(nil),             // 71: essay.show.DiffReviewReq
+	(*ListPromptsReq)(nil),            // 72: essay.show.ListPromptsReq
+	(*GetPromptReq)(nil),              // 73: essay.show.GetPromptReq
+	(*CreatePromptReq)(nil),           // 74: essay.show.CreatePromptReq
+	(*UpdatePromptReq)(nil),           // 75: essay.show.UpdatePromptReq
+	(*UpdatePromptStatusReq)(nil),     // 76: essay.show.UpdatePromptStatusReq
+	(*ListModerationsReq)(nil),        // 77: essay.show.ListModerationsReq
+	(*ResolveModerationReq)(nil),      // 78: essay.show.ResolveModerationReq
+	(*CreateExerciseReq)(nil),         // 79: essay.show.CreateExerciseReq
+	(*ListSimpleExercisesReq)(nil),    // 80: essay.show.ListSimpleExercisesReq
+	(*GetExerciseReq)(nil),            // 81: essay.show.GetExerciseReq
+	(*DoExerciseReq)(nil),             // 82: essay.show.DoExerciseReq
+	(*LikeExerciseReq)(nil),           // 83: essay.show.LikeExerciseReq
+	(*ReportQuestionReq)(nil),         // 84: essay.show.ReportQuestionReq
+	(*ListQuestionReportsReq)(nil),    // 85: essay.show.ListQuestionReportsReq
+	(*DeleteExerciseReq)(nil),         // 86: essay.show.DeleteExerciseReq
+	(*RegenerateExerciseReq)(nil),     // 87: essay.show.RegenerateExerciseReq
+	(*SignUpResp)(nil),                // 88: essay.show.SignUpResp
+	(*SignInResp)(nil),                // 89: essay.show.SignInResp
+	(*GetUserInfoResp)(nil),           // 90: essay.show.GetUserInfoResp
+	(*Response)(nil),                  // 91: essay.show.Response
+	(*GetDailyAttendResp)(nil),        // 92: essay.show.GetDailyAttendResp
+	(*GetInvitationCodeResp)(nil),     // 93: essay.show.GetInvitationCodeResp
+	(*EssayEvaluateResp)(nil),         // 94: essay.show.EssayEvaluateResp
+	(*GetEssayEvaluateLogsResp)(nil),  // 95: essay.show.GetEssayEvaluateLogsResp
+	(*OCRResp)(nil),                   // 96: essay.show.OCRResp
+	(*ApplySignedUrlResp)(nil),        // 97: essay.show.ApplySignedUrlResp
+	(*GetRankResp)(nil),               // 98: essay.show.GetRankResp
+	(*ListAchievementsResp)(nil),      // 99: essay.show.ListAchievementsResp
+	(*GetQuotaHistoryResp)(nil),       // 100: essay.show.GetQuotaHistoryResp
+	(*AuditQuotaResp)(nil),            // 101: essay.show.AuditQuotaResp
+	(*CreateVoucherBatchResp)(nil),    // 102: essay.show.CreateVoucherBatchResp
+	(*RedeemVoucherResp)(nil),         // 103: essay.show.RedeemVoucherResp
+	(*ListProductsResp)(nil),          // 104: essay.show.ListProductsResp
+	(*CreateOrderResp)(nil),           // 105: essay.show.CreateOrderResp
+	(*GetOrderResp)(nil),              // 106: essay.show.GetOrderResp
+	(*ListOrdersResp)(nil),            // 107: essay.show.ListOrdersResp
+	(*ListInviteesResp)(nil),          // 108: essay.show.ListInviteesResp
+	(*ListInvitationReviewsResp)(nil), // 109: essay.show.ListInvitationReviewsResp
+	(*SearchUsersResp)(nil),           // 110: essay.show.SearchUsersResp
+	(*GetUserDetailResp)(nil),         // 111: essay.show.GetUserDetailResp
+	(*AdjustQuotaResp)(nil),           // 112: essay.show.AdjustQuotaResp
+	(*ListAuditLogsResp)(nil),         // 113: essay.show.ListAuditLogsResp
+	(*ListFeedbacksResp)(nil),         // 114: essay.show.ListFeedbacksResp
+	(*GetFeedbackResp)(nil),           // 115: essay.show.GetFeedbackResp
+	(*FeedbackStatsResp)(nil),         // 116: essay.show.FeedbackStatsResp
+	(*ListNotificationsResp)(nil),     // 117: essay.show.ListNotificationsResp
+	(*DeleteAccountResp)(nil),         // 118: essay.show.DeleteAccountResp
+	(*CreateLinkCodeResp)(nil),        // 119: essay.show.CreateLinkCodeResp
+	(*ConfirmLinkResp)(nil),           // 120: essay.show.ConfirmLinkResp
+	(*ListLinksResp)(nil),             // 121: essay.show.ListLinksResp
+	(*GetStudentStatsResp)(nil),       // 122: essay.show.GetStudentStatsResp
+	(*CreateClassResp)(nil),           // 123: essay.show.CreateClassResp
+	(*ListClassesResp)(nil),           // 124: essay.show.ListClassesResp
+	(*JoinClassResp)(nil),             // 125: essay.show.JoinClassResp
+	(*ListClassMembersResp)(nil),      // 126: essay.show.ListClassMembersResp
+	(*CreateAssignmentResp)(nil),      // 127: essay.show.CreateAssignmentResp
+	(*ListAssignmentsResp)(nil),       // 128: essay.show.ListAssignmentsResp
+	(*GetAssignmentReportResp)(nil),   // 129: essay.show.GetAssignmentReportResp
+	(*SaveReviewResp)(nil),            // 130: essay.show.SaveReviewResp
+	(*GetReviewResp)(nil),             // 131: essay.show.GetReviewResp
+	(*ListReviewVersionsResp)(nil),    // 132: essay.show.ListReviewVersionsResp
+	(*DiffReviewResp)(nil),            // 133: essay.show.DiffReviewResp
+	(*ListPromptsResp)(nil),           // 134: essay.show.ListPromptsResp
+	(*GetPromptResp)(nil),             // 135: essay.show.GetPromptResp
+	(*CreatePromptResp)(nil),          // 136: essay.show.CreatePromptResp
+	(*ListModerationsResp)(nil),       // 137: essay.show.ListModerationsResp
+	(*CreateExerciseResp)(nil),        // 138: essay.show.CreateExerciseResp
+	(*ListSimpleExercisesResp)(nil),   // 139: essay.show.ListSimpleExercisesResp
+	(*GetExerciseResp)(nil),           // 140: essay.show.GetExerciseResp
+	(*DoExerciseResp)(nil),            // 141: essay.show.DoExerciseResp
+	(*ListQuestionReportsResp)(nil),   // 142: essay.show.ListQuestionReportsResp
 }
 var file_show_proto_depIdxs = []int32{
-	0,  // 0: essay.show.show.SignUp:input_type -> essay.show.SignUpReq
-	1,  // 1: essay.show.show.SignIn:input_type -> essay.show.SignInReq
-	2,  // 2: essay.show.show.GetUserInfo:input_type -> essay.show.GetUserInfoReq
-	3,  // 3: essay.show.show.UpdatePassword:input_type -> essay.show.UpdatePasswordReq
-	4,  // 4: essay.show.show.UpdateUserInfo:input_type -> essay.show.UpdateUserInfoReq
-	5,  // 5: essay.show.show.DailyAttend:input_type -> essay.show.DailyAttendReq
-	6,  // 6: essay.show.show.GetDailyAttend:input_type -> essay.show.GetDailyAttendReq
-	7,  // 7: essay.show.show.GetInvitationCode:input_type -> essay.show.GetInvitationCodeReq
-	8,  // 8: essay.show.show.FillInvitationCode:input_type -> essay.show.FillInvitationCodeReq
-	9,  // 9: essay.show.show.EssayEvaluate:input_type -> essay.show.EssayEvaluateReq
-	10, // 10: essay.show.show.LikeEvaluate:input_type -> essay.show.LikeEvaluateReq
-	11, // 11: essay.show.show.GetEvaluateLogs:input_type -> essay.show.GetEssayEvaluateLogsReq
-	12, // 12: essay.show.show.OCR:input_type -> essay.show.OCRReq
-	13, // 13: essay.show.show.ApplySignedUrl:input_type -> essay.show.ApplySignedUrlReq
-	14, // 14: essay.show.show.SendVerifyCode:input_type -> essay.show.SendVerifyCodeReq
-	15, // 15: essay.show.show.SubmitFeedback:input_type -> essay.show.SubmitFeedbackReq
-	16, // 16: essay.show.exercise.CreateExercise:input_type -> essay.show.CreateExerciseReq
-	17, // 17: essay.show.exercise.ListSimpleExercises:input_type -> essay.show.ListSimpleExercisesReq
-	18, // 18: essay.show.exercise.GetExercise:input_type -> essay.show.GetExerciseReq
-	19, // 19: essay.show.exercise.DoExercise:input_type -> essay.show.DoExerciseReq
-	20, // 20: essay.show.exercise.LikeExercise:input_type -> essay.show.LikeExerciseReq
-	21, // 21: essay.show.show.SignUp:output_type -> essay.show.SignUpResp
-	22, // 22: essay.show.show.SignIn:output_type -> essay.show.SignInResp
-	23, // 23: essay.show.show.GetUserInfo:output_type -> essay.show.GetUserInfoResp
-	3,  // 24: essay.show.show.UpdatePassword:output_type -> essay.show.UpdatePasswordReq
-	24, // 25: essay.show.show.UpdateUserInfo:output_type -> essay.show.Response
-	24, // 26: essay.show.show.DailyAttend:output_type -> essay.show.Response
-	25, // 27: essay.show.show.GetDailyAttend:output_type -> essay.show.GetDailyAttendResp
-	26, // 28: essay.show.show.GetInvitationCode:output_type -> essay.show.GetInvitationCodeResp
-	24, // 29: essay.show.show.FillInvitationCode:output_type -> essay.show.Response
-	27, // 30: essay.show.show.EssayEvaluate:output_type -> essay.show.EssayEvaluateResp
-	24, // 31: essay.show.show.LikeEvaluate:output_type -> essay.show.Response
-	28, // 32: essay.show.show.GetEvaluateLogs:output_type -> essay.show.GetEssayEvaluateLogsResp
-	29, // 33: essay.show.show.OCR:output_type -> essay.show.OCRResp
-	30, // 34: essay.show.show.ApplySignedUrl:output_type -> essay.show.ApplySignedUrlResp
-	24, // 35: essay.show.show.SendVerifyCode:output_type -> essay.show.Response
-	24, // 36: essay.show.show.SubmitFeedback:output_type -> essay.show.Response
-	31, // 37: essay.show.exercise.CreateExercise:output_type -> essay.show.CreateExerciseResp
-	32, // 38: essay.show.exercise.ListSimpleExercises:output_type -> essay.show.ListSimpleExercisesResp
-	33, // 39: essay.show.exercise.GetExercise:output_type -> essay.show.GetExerciseResp
-	34, // 40: essay.show.exercise.DoExercise:output_type -> essay.show.DoExerciseResp
-	24, // 41: essay.show.exercise.LikeExercise:output_type -> essay.show.Response
-	21, // [21:42] is the sub-list for method output_type
-	0,  // [0:21] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: essay.show.show.SignUp:input_type -> essay.show.SignUpReq
+	1,   // 1: essay.show.show.SignIn:input_type -> essay.show.SignInReq
+	2,   // 2: essay.show.show.GetUserInfo:input_type -> essay.show.GetUserInfoReq
+	3,   // 3: essay.show.show.UpdatePassword:input_type -> essay.show.UpdatePasswordReq
+	4,   // 4: essay.show.show.UpdateUserInfo:input_type -> essay.show.UpdateUserInfoReq
+	5,   // 5: essay.show.show.DailyAttend:input_type -> essay.show.DailyAttendReq
+	6,   // 6: essay.show.show.GetDailyAttend:input_type -> essay.show.GetDailyAttendReq
+	7,   // 7: essay.show.show.MakeUpAttend:input_type -> essay.show.MakeUpAttendReq
+	8,   // 8: essay.show.show.GetInvitationCode:input_type -> essay.show.GetInvitationCodeReq
+	9,   // 9: essay.show.show.FillInvitationCode:input_type -> essay.show.FillInvitationCodeReq
+	10,  // 10: essay.show.show.EssayEvaluate:input_type -> essay.show.EssayEvaluateReq
+	11,  // 11: essay.show.show.LikeEvaluate:input_type -> essay.show.LikeEvaluateReq
+	12,  // 12: essay.show.show.GetEvaluateLogs:input_type -> essay.show.GetEssayEvaluateLogsReq
+	13,  // 13: essay.show.show.OCR:input_type -> essay.show.OCRReq
+	14,  // 14: essay.show.show.ApplySignedUrl:input_type -> essay.show.ApplySignedUrlReq
+	15,  // 15: essay.show.show.SendVerifyCode:input_type -> essay.show.SendVerifyCodeReq
+	16,  // 16: essay.show.show.SubmitFeedback:input_type -> essay.show.SubmitFeedbackReq
+	17,  // 17: essay.show.show.GetRank:input_type -> essay.show.GetRankReq
+	18,  // 18: essay.show.show.UpdateRankPrivacy:input_type -> essay.show.UpdateRankPrivacyReq
+	19,  // 19: essay.show.show.ListAchievements:input_type -> essay.show.ListAchievementsReq
+	20,  // 20: essay.show.show.GetQuotaHistory:input_type -> essay.show.GetQuotaHistoryReq
+	21,  // 21: essay.show.show.AuditQuota:input_type -> essay.show.AuditQuotaReq
+	22,  // 22: essay.show.show.CreateVoucherBatch:input_type -> essay.show.CreateVoucherBatchReq
+	23,  // 23: essay.show.show.RedeemVoucher:input_type -> essay.show.RedeemVoucherReq
+	24,  // 24: essay.show.show.GrantPlan:input_type -> essay.show.GrantPlanReq
+	25,  // 25: essay.show.show.ListProducts:input_type -> essay.show.ListProductsReq
+	26,  // 26: essay.show.show.CreateOrder:input_type -> essay.show.CreateOrderReq
+	27,  // 27: essay.show.show.GetOrder:input_type -> essay.show.GetOrderReq
+	28,  // 28: essay.show.show.ListOrders:input_type -> essay.show.ListOrdersReq
+	29,  // 29: essay.show.show.RefundOrder:input_type -> essay.show.RefundOrderReq
+	30,  // 30: essay.show.show.ListInvitees:input_type -> essay.show.ListInviteesReq
+	31,  // 31: essay.show.show.ListInvitationReviews:input_type -> essay.show.ListInvitationReviewsReq
+	32,  // 32: essay.show.show.ReviewInvitation:input_type -> essay.show.ReviewInvitationReq
+	33,  // 33: essay.show.show.UpdateInvitationCode:input_type -> essay.show.UpdateInvitationCodeReq
+	34,  // 34: essay.show.show.SetUserRole:input_type -> essay.show.SetUserRoleReq
+	35,  // 35: essay.show.show.SearchUsers:input_type -> essay.show.SearchUsersReq
+	36,  // 36: essay.show.show.GetUserDetail:input_type -> essay.show.GetUserDetailReq
+	37,  // 37: essay.show.show.AdjustQuota:input_type -> essay.show.AdjustQuotaReq
+	38,  // 38: essay.show.show.BanUser:input_type -> essay.show.BanUserReq
+	39,  // 39: essay.show.show.ForceLogout:input_type -> essay.show.ForceLogoutReq
+	40,  // 40: essay.show.show.ListAuditLogs:input_type -> essay.show.ListAuditLogsReq
+	41,  // 41: essay.show.show.ListMyFeedbacks:input_type -> essay.show.ListMyFeedbacksReq
+	42,  // 42: essay.show.show.ListFeedbacks:input_type -> essay.show.ListFeedbacksReq
+	43,  // 43: essay.show.show.AssignFeedback:input_type -> essay.show.AssignFeedbackReq
+	44,  // 44: essay.show.show.UpdateFeedbackStatus:input_type -> essay.show.UpdateFeedbackStatusReq
+	45,  // 45: essay.show.show.ReplyFeedback:input_type -> essay.show.ReplyFeedbackReq
+	46,  // 46: essay.show.show.GetFeedback:input_type -> essay.show.GetFeedbackReq
+	47,  // 47: essay.show.show.FeedbackStats:input_type -> essay.show.FeedbackStatsReq
+	48,  // 48: essay.show.show.ListNotifications:input_type -> essay.show.ListNotificationsReq
+	49,  // 49: essay.show.show.ReadNotifications:input_type -> essay.show.ReadNotificationsReq
+	50,  // 50: essay.show.show.ReadAllNotifications:input_type -> essay.show.ReadAllNotificationsReq
+	51,  // 51: essay.show.show.ExportUserData:input_type -> essay.show.ExportUserDataReq
+	52,  // 52: essay.show.show.DeleteAccount:input_type -> essay.show.DeleteAccountReq
+	53,  // 53: essay.show.show.CancelDeleteAccount:input_type -> essay.show.CancelDeleteAccountReq
+	54,  // 54: essay.show.show.CreateLinkCode:input_type -> essay.show.CreateLinkCodeReq
+	55,  // 55: essay.show.show.ConfirmLink:input_type -> essay.show.ConfirmLinkReq
+	56,  // 56: essay.show.show.ListLinks:input_type -> essay.show.ListLinksReq
+	57,  // 57: essay.show.show.RevokeLink:input_type -> essay.show.RevokeLinkReq
+	58,  // 58: essay.show.show.GetStudentStats:input_type -> essay.show.GetStudentStatsReq
+	59,  // 59: essay.show.show.CreateClass:input_type -> essay.show.CreateClassReq
+	60,  // 60: essay.show.show.ListClasses:input_type -> essay.show.ListClassesReq
+	61,  // 61: essay.show.show.JoinClass:input_type -> essay.show.JoinClassReq
+	62,  // 62: essay.show.show.LeaveClass:input_type -> essay.show.LeaveClassReq
+	63,  // 63: essay.show.show.ListClassMembers:input_type -> essay.show.ListClassMembersReq
+	64,  // 64: essay.show.show.RemoveClassMember:input_type -> essay.show.RemoveClassMemberReq
+	65,  // 65: essay.show.show.CreateAssignment:input_type -> essay.show.CreateAssignmentReq
+	66,  // 66: essay.show.show.ListAssignments:input_type -> essay.show.ListAssignmentsReq
+	67,  // 67: essay.show.show.GetAssignmentReport:input_type -> essay.show.GetAssignmentReportReq
+	68,  // 68: essay.show.show.SaveReview:input_type -> essay.show.SaveReviewReq
+	69,  // 69: essay.show.show.GetReview:input_type -> essay.show.GetReviewReq
+	70,  // 70: essay.show.show.ListReviewVersions:input_type -> essay.show.ListReviewVersionsReq
+	71,  // 71: essay.show.show.DiffReview:input_type -> essay.show.DiffReviewReq
+	72,  // 72: essay.show.show.ListPrompts:input_type -> essay.show.ListPromptsReq
+	73,  // 73: essay.show.show.GetPrompt:input_type -> essay.show.GetPromptReq
+	74,  // 74: essay.show.show.CreatePrompt:input_type -> essay.show.CreatePromptReq
+	75,  // 75: essay.show.show.UpdatePrompt:input_type -> essay.show.UpdatePromptReq
+	76,  // 76: essay.show.show.UpdatePromptStatus:input_type -> essay.show.UpdatePromptStatusReq
+	72,  // 77: essay.show.show.ListAllPrompts:input_type -> essay.show.ListPromptsReq
+	77,  // 78: essay.show.show.ListModerations:input_type -> essay.show.ListModerationsReq
+	78,  // 79: essay.show.show.ResolveModeration:input_type -> essay.show.ResolveModerationReq
+	79,  // 80: essay.show.exercise.CreateExercise:input_type -> essay.show.CreateExerciseReq
+	80,  // 81: essay.show.exercise.ListSimpleExercises:input_type -> essay.show.ListSimpleExercisesReq
+	81,  // 82: essay.show.exercise.GetExercise:input_type -> essay.show.GetExerciseReq
+	82,  // 83: essay.show.exercise.DoExercise:input_type -> essay.show.DoExerciseReq
+	83,  // 84: essay.show.exercise.LikeExercise:input_type -> essay.show.LikeExerciseReq
+	84,  // 85: essay.show.exercise.ReportQuestion:input_type -> essay.show.ReportQuestionReq
+	85,  // 86: essay.show.exercise.ListQuestionReports:input_type -> essay.show.ListQuestionReportsReq
+	86,  // 87: essay.show.exercise.DeleteExercise:input_type -> essay.show.DeleteExerciseReq
+	87,  // 88: essay.show.exercise.RegenerateExercise:input_type -> essay.show.RegenerateExerciseReq
+	88,  // 89: essay.show.show.SignUp:output_type -> essay.show.SignUpResp
+	89,  // 90: essay.show.show.SignIn:output_type -> essay.show.SignInResp
+	90,  // 91: essay.show.show.GetUserInfo:output_type -> essay.show.GetUserInfoResp
+	3,   // 92: essay.show.show.UpdatePassword:output_type -> essay.show.UpdatePasswordReq
+	91,  // 93: essay.show.show.UpdateUserInfo:output_type -> essay.show.Response
+	91,  // 94: essay.show.show.DailyAttend:output_type -> essay.show.Response
+	92,  // 95: essay.show.show.GetDailyAttend:output_type -> essay.show.GetDailyAttendResp
+	91,  // 96: essay.show.show.MakeUpAttend:output_type -> essay.show.Response
+	93,  // 97: essay.show.show.GetInvitationCode:output_type -> essay.show.GetInvitationCodeResp
+	91,  // 98: essay.show.show.FillInvitationCode:output_type -> essay.show.Response
+	94,  // 99: essay.show.show.EssayEvaluate:output_type -> essay.show.EssayEvaluateResp
+	91,  // 100: essay.show.show.LikeEvaluate:output_type -> essay.show.Response
+	95,  // 101: essay.show.show.GetEvaluateLogs:output_type -> essay.show.GetEssayEvaluateLogsResp
+	96,  // 102: essay.show.show.OCR:output_type -> essay.show.OCRResp
+	97,  // 103: essay.show.show.ApplySignedUrl:output_type -> essay.show.ApplySignedUrlResp
+	91,  // 104: essay.show.show.SendVerifyCode:output_type -> essay.show.Response
+	91,  // 105: essay.show.show.SubmitFeedback:output_type -> essay.show.Response
+	98,  // 106: essay.show.show.GetRank:output_type -> essay.show.GetRankResp
+	91,  // 107: essay.show.show.UpdateRankPrivacy:output_type -> essay.show.Response
+	99,  // 108: essay.show.show.ListAchievements:output_type -> essay.show.ListAchievementsResp
+	100, // 109: essay.show.show.GetQuotaHistory:output_type -> essay.show.GetQuotaHistoryResp
+	101, // 110: essay.show.show.AuditQuota:output_type -> essay.show.AuditQuotaResp
+	102, // 111: essay.show.show.CreateVoucherBatch:output_type -> essay.show.CreateVoucherBatchResp
+	103, // 112: essay.show.show.RedeemVoucher:output_type -> essay.show.RedeemVoucherResp
+	91,  // 113: essay.show.show.GrantPlan:output_type -> essay.show.Response
+	104, // 114: essay.show.show.ListProducts:output_type -> essay.show.ListProductsResp
+	105, // 115: essay.show.show.CreateOrder:output_type -> essay.show.CreateOrderResp
+	106, // 116: essay.show.show.GetOrder:output_type -> essay.show.GetOrderResp
+	107, // 117: essay.show.show.ListOrders:output_type -> essay.show.ListOrdersResp
+	91,  // 118: essay.show.show.RefundOrder:output_type -> essay.show.Response
+	108, // 119: essay.show.show.ListInvitees:output_type -> essay.show.ListInviteesResp
+	109, // 120: essay.show.show.ListInvitationReviews:output_type -> essay.show.ListInvitationReviewsResp
+	91,  // 121: essay.show.show.ReviewInvitation:output_type -> essay.show.Response
+	91,  // 122: essay.show.show.UpdateInvitationCode:output_type -> essay.show.Response
+	91,  // 123: essay.show.show.SetUserRole:output_type -> essay.show.Response
+	110, // 124: essay.show.show.SearchUsers:output_type -> essay.show.SearchUsersResp
+	111, // 125: essay.show.show.GetUserDetail:output_type -> essay.show.GetUserDetailResp
+	112, // 126: essay.show.show.AdjustQuota:output_type -> essay.show.AdjustQuotaResp
+	91,  // 127: essay.show.show.BanUser:output_type -> essay.show.Response
+	91,  // 128: essay.show.show.ForceLogout:output_type -> essay.show.Response
+	113, // 129: essay.show.show.ListAuditLogs:output_type -> essay.show.ListAuditLogsResp
+	114, // 130: essay.show.show.ListMyFeedbacks:output_type -> essay.show.ListFeedbacksResp
+	114, // 131: essay.show.show.ListFeedbacks:output_type -> essay.show.ListFeedbacksResp
+	91,  // 132: essay.show.show.AssignFeedback:output_type -> essay.show.Response
+	91,  // 133: essay.show.show.UpdateFeedbackStatus:output_type -> essay.show.Response
+	91,  // 134: essay.show.show.ReplyFeedback:output_type -> essay.show.Response
+	115, // 135: essay.show.show.GetFeedback:output_type -> essay.show.GetFeedbackResp
+	116, // 136: essay.show.show.FeedbackStats:output_type -> essay.show.FeedbackStatsResp
+	117, // 137: essay.show.show.ListNotifications:output_type -> essay.show.ListNotificationsResp
+	91,  // 138: essay.show.show.ReadNotifications:output_type -> essay.show.Response
+	91,  // 139: essay.show.show.ReadAllNotifications:output_type -> essay.show.Response
+	91,  // 140: essay.show.show.ExportUserData:output_type -> essay.show.Response
+	118, // 141: essay.show.show.DeleteAccount:output_type -> essay.show.DeleteAccountResp
+	91,  // 142: essay.show.show.CancelDeleteAccount:output_type -> essay.show.Response
+	119, // 143: essay.show.show.CreateLinkCode:output_type -> essay.show.CreateLinkCodeResp
+	120, // 144: essay.show.show.ConfirmLink:output_type -> essay.show.ConfirmLinkResp
+	121, // 145: essay.show.show.ListLinks:output_type -> essay.show.ListLinksResp
+	91,  // 146: essay.show.show.RevokeLink:output_type -> essay.show.Response
+	122, // 147: essay.show.show.GetStudentStats:output_type -> essay.show.GetStudentStatsResp
+	123, // 148: essay.show.show.CreateClass:output_type -> essay.show.CreateClassResp
+	124, // 149: essay.show.show.ListClasses:output_type -> essay.show.ListClassesResp
+	125, // 150: essay.show.show.JoinClass:output_type -> essay.show.JoinClassResp
+	91,  // 151: essay.show.show.LeaveClass:output_type -> essay.show.Response
+	126, // 152: essay.show.show.ListClassMembers:output_type -> essay.show.ListClassMembersResp
+	91,  // 153: essay.show.show.RemoveClassMember:output_type -> essay.show.Response
+	127, // 154: essay.show.show.CreateAssignment:output_type -> essay.show.CreateAssignmentResp
+	128, // 155: essay.show.show.ListAssignments:output_type -> essay.show.ListAssignmentsResp
+	129, // 156: essay.show.show.GetAssignmentReport:output_type -> essay.show.GetAssignmentReportResp
+	130, // 157: essay.show.show.SaveReview:output_type -> essay.show.SaveReviewResp
+	131, // 158: essay.show.show.GetReview:output_type -> essay.show.GetReviewResp
+	132, // 159: essay.show.show.ListReviewVersions:output_type -> essay.show.ListReviewVersionsResp
+	133, // 160: essay.show.show.DiffReview:output_type -> essay.show.DiffReviewResp
+	134, // 161: essay.show.show.ListPrompts:output_type -> essay.show.ListPromptsResp
+	135, // 162: essay.show.show.GetPrompt:output_type -> essay.show.GetPromptResp
+	136, // 163: essay.show.show.CreatePrompt:output_type -> essay.show.CreatePromptResp
+	91,  // 164: essay.show.show.UpdatePrompt:output_type -> essay.show.Response
+	91,  // 165: essay.show.show.UpdatePromptStatus:output_type -> essay.show.Response
+	134, // 166: essay.show.show.ListAllPrompts:output_type -> essay.show.ListPromptsResp
+	137, // 167: essay.show.show.ListModerations:output_type -> essay.show.ListModerationsResp
+	91,  // 168: essay.show.show.ResolveModeration:output_type -> essay.show.Response
+	138, // 169: essay.show.exercise.CreateExercise:output_type -> essay.show.CreateExerciseResp
+	139, // 170: essay.show.exercise.ListSimpleExercises:output_type -> essay.show.ListSimpleExercisesResp
+	140, // 171: essay.show.exercise.GetExercise:output_type -> essay.show.GetExerciseResp
+	141, // 172: essay.show.exercise.DoExercise:output_type -> essay.show.DoExerciseResp
+	91,  // 173: essay.show.exercise.LikeExercise:output_type -> essay.show.Response
+	91,  // 174: essay.show.exercise.ReportQuestion:output_type -> essay.show.Response
+	142, // 175: essay.show.exercise.ListQuestionReports:output_type -> essay.show.ListQuestionReportsResp
+	91,  // 176: essay.show.exercise.DeleteExercise:output_type -> essay.show.Response
+	138, // 177: essay.show.exercise.RegenerateExercise:output_type -> essay.show.CreateExerciseResp
+	89,  // [89:178] is the sub-list for method output_type
+	0,   // [0:89] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func file_show_proto_init() {
@@ -0,0 +1,272 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/achievement"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/class"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/exercise"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/feedback"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/invitation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/moderation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/relation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/review"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/session"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/voucher"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"time"
+)
+
+type IAccountService interface {
+	ExportUserData(ctx context.Context, req *show.ExportUserDataReq) ([]byte, error)
+	DeleteAccount(ctx context.Context, req *show.DeleteAccountReq) (*show.DeleteAccountResp, error)
+	CancelDeleteAccount(ctx context.Context, req *show.CancelDeleteAccountReq) (*show.Response, error)
+	Purge(ctx context.Context) error
+}
+
+// AccountService 处理个人数据的导出和账号注销
+// 注销后删除用户名下的作文、练习等数据, 订单、账本和权益作为交易凭证保留, 其中只有匿名的用户id
+// 教师创建的班级、作业和批阅供学生继续使用, 只清除其中教师的身份
+type AccountService struct {
+	UserMapper         *user.MongoMapper
+	AttendMapper       *attend.MongoMapper
+	CodeMapper         *invitation.CodeMongoMapper
+	InvitationMapper   *invitation.LogMongoMapper
+	EvaluateLogMapper  *log.MongoMapper
+	ExerciseMapper     *exercise.MongoMapper
+	ReportMapper       *exercise.ReportMongoMapper
+	FeedbackMapper     *feedback.MongoMapper
+	AchievementMapper  *achievement.MongoMapper
+	ProgressMapper     *achievement.ProgressMongoMapper
+	NotificationMapper *notification.MongoMapper
+	RelationMapper     *relation.MongoMapper
+	MemberMapper       *class.MemberMongoMapper
+	ClassMapper        *class.ClassMongoMapper
+	AssignmentMapper   *class.AssignmentMongoMapper
+	ReviewMapper       *review.MongoMapper
+	ModerationMapper   *moderation.MongoMapper
+	RedemptionMapper   *voucher.RedemptionMongoMapper
+	SessionMapper      *session.RedisMapper
+	RankService        IRankService
+	QuotaService       IQuotaService
+	Clock              clock.Clock
+}
+
+var AccountServiceSet = wire.NewSet(
+	wire.Struct(new(AccountService), "*"),
+	wire.Bind(new(IAccountService), new(*AccountService)),
+)
+
+// invitationExport 导出的邀请记录, 不包含风控和审核信息
+type invitationExport struct {
+	Inviter      string    `json:"inviter"`
+	Invitee      string    `json:"invitee"`
+	Status       int64     `json:"status"`
+	Timestamp    time.Time `json:"timestamp"`
+	ActivateTime time.Time `json:"activateTime,omitempty"`
+}
+
+// ExportUserData 将用户的个人数据打包为ZIP, 每类数据一个JSON文件, 作文图片以原始URL的形式导出
+func (s *AccountService) ExportUserData(ctx context.Context, req *show.ExportUserDataReq) ([]byte, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	userId := meta.GetUserId()
+
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+	logs, err := s.EvaluateLogMapper.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	images := make([]string, 0)
+	for _, l := range logs {
+		images = append(images, l.Ocr...)
+	}
+	exercises, err := s.ExerciseMapper.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	as, err := s.AttendMapper.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	ls, err := s.InvitationMapper.FindAllByUser(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	invitations := make([]*invitationExport, 0, len(ls))
+	for _, l := range ls {
+		invitations = append(invitations, &invitationExport{
+			Inviter:      l.Inviter,
+			Invitee:      l.Invitee,
+			Status:       l.Status,
+			Timestamp:    l.Timestamp,
+			ActivateTime: l.ActivateTime,
+		})
+	}
+	var code string
+	if c, err := s.CodeMapper.FindOneByUserId(ctx, userId); err == nil {
+		code = c.Code
+	}
+	fs, err := s.FeedbackMapper.FindAllByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	files := []struct {
+		name string
+		v    any
+	}{
+		{"profile.json", u},
+		{"logs.json", logs},
+		{"images.json", images},
+		{"exercises.json", exercises},
+		{"attendance.json", as},
+		{"invitations.json", map[string]any{"code": code, "records": invitations}},
+		{"feedbacks.json", fs},
+	}
+	for _, f := range files {
+		fw, err := w.Create(f.name)
+		if err != nil {
+			return nil, err
+		}
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "  ")
+		if err = enc.Encode(f.v); err != nil {
+			return nil, err
+		}
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DeleteAccount 校验验证码后申请注销账号, 冷静期结束后由定时任务清除数据
+func (s *AccountService) DeleteAccount(ctx context.Context, req *show.DeleteAccountReq) (*show.DeleteAccountResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	u, err := s.UserMapper.FindOne(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+	if u.Status == consts.DeleteStatus {
+		return nil, consts.ErrUserDeleted
+	}
+	// 已在冷静期内时不重新计时
+	if !u.DeleteTime.IsZero() {
+		return &show.DeleteAccountResp{
+			Code:       0,
+			Msg:        "已申请注销",
+			DeleteTime: u.DeleteTime.Unix(),
+		}, nil
+	}
+
+	// 通过中台校验验证码
+	if _, err = util.GetHttpClient().SignUp(ctx, consts.Phone, u.Phone, &req.VerifyCode); err != nil {
+		return nil, consts.ErrVerifyCode
+	}
+
+	t := s.Clock.Now().AddDate(0, 0, int(config.GetConfig().Account.DeleteCooling))
+	if err = s.UserMapper.ScheduleDelete(ctx, u.ID.Hex(), t); err != nil {
+		return nil, consts.ErrUpdate
+	}
+	return &show.DeleteAccountResp{
+		Code:       0,
+		Msg:        "已申请注销",
+		DeleteTime: t.Unix(),
+	}, nil
+}
+
+// CancelDeleteAccount 在冷静期内撤销注销申请
+func (s *AccountService) CancelDeleteAccount(ctx context.Context, req *show.CancelDeleteAccountReq) (*show.Response, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	if err := s.UserMapper.CancelDelete(ctx, meta.GetUserId()); err != nil {
+		return nil, consts.ErrUpdate
+	}
+	return util.Succeed("已撤销注销")
+}
+
+// Purge 清除冷静期已过的账号的数据, 单个账号失败时下次重试
+func (s *AccountService) Purge(ctx context.Context) error {
+	us, err := s.UserMapper.FindDueDeletion(ctx, s.Clock.Now())
+	if err != nil {
+		return err
+	}
+	for _, u := range us {
+		if err = s.purge(ctx, u); err != nil {
+			logx.CtxError(ctx, "account: purge %s error %v", u.ID.Hex(), err)
+		}
+	}
+	return nil
+}
+
+// purge 删除或匿名化用户在各集合中的数据, 最后匿名化用户本身, 因此中途失败可以整体重试
+func (s *AccountService) purge(ctx context.Context, u *user.User) error {
+	userId := u.ID.Hex()
+	if err := s.RankService.Remove(ctx, u); err != nil {
+		return err
+	}
+	deletes := []func(ctx context.Context, userId string) error{
+		s.EvaluateLogMapper.DeleteByUserId,
+		s.ExerciseMapper.DeleteByUserId,
+		s.ReportMapper.DeleteByUserId,
+		s.AttendMapper.DeleteByUserId,
+		s.CodeMapper.DeleteByUserId,
+		s.InvitationMapper.Anonymize,
+		s.FeedbackMapper.DeleteByUserId,
+		s.AchievementMapper.DeleteByUserId,
+		s.ProgressMapper.DeleteByUserId,
+		s.NotificationMapper.DeleteByUserId,
+		s.RelationMapper.DeleteByUserId,
+		s.MemberMapper.DeleteByUserId,
+		s.ReviewMapper.DeleteByUserId,
+		s.ModerationMapper.DeleteByUserId,
+		s.RedemptionMapper.DeleteByUserId,
+		s.ClassMapper.AnonymizeTeacher,
+		s.AssignmentMapper.AnonymizeTeacher,
+		s.ReviewMapper.AnonymizeTeacher,
+	}
+	for _, d := range deletes {
+		if err := d(ctx, userId); err != nil {
+			return err
+		}
+	}
+	now := s.Clock.Now()
+	if err := s.SessionMapper.Revoke(ctx, userId, now); err != nil {
+		return err
+	}
+	// 剩余次数经账本清零, 重试时不会重复扣除
+	if u.Count != 0 {
+		if _, err := s.QuotaService.ChangeOnce(ctx, userId, -u.Count, ledger.ReasonDeletion, userId); err != nil {
+			return err
+		}
+	}
+	return s.UserMapper.Anonymize(ctx, userId, now)
+}
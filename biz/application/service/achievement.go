@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/achievement"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+)
+
+// streakWindow 计算连续签到天数时回看的天数
+const streakWindow = 31
+
+type IAchievementService interface {
+	ListAchievements(ctx context.Context, req *show.ListAchievementsReq) (*show.ListAchievementsResp, error)
+	Subscribe()
+}
+
+// AchievementService 监听业务事件更新统计指标, 并根据徽章规则发放徽章
+type AchievementService struct {
+	AchievementMapper *achievement.MongoMapper
+	ProgressMapper    *achievement.ProgressMongoMapper
+	AttendMapper      *attend.MongoMapper
+	Bus               *event.Bus
+	Clock             clock.Clock
+	QuotaService      IQuotaService
+}
+
+var AchievementServiceSet = wire.NewSet(
+	wire.Struct(new(AchievementService), "*"),
+	wire.Bind(new(IAchievementService), new(*AchievementService)),
+)
+
+// ListAchievements 列出所有徽章及用户的获得进度
+func (s *AchievementService) ListAchievements(ctx context.Context, req *show.ListAchievementsReq) (*show.ListAchievementsResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	// 已获得的徽章
+	as, err := s.AchievementMapper.FindByUserId(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	earned := make(map[string]*achievement.Achievement, len(as))
+	for _, a := range as {
+		earned[a.Badge] = a
+	}
+
+	// 各项指标的进度
+	metrics := map[string]int64{}
+	p, err := s.ProgressMapper.FindOneByUserId(ctx, meta.GetUserId())
+	if err == nil {
+		metrics = p.Metrics
+	} else if !errors.Is(err, consts.ErrNotFound) {
+		return nil, err
+	}
+
+	// 构造响应
+	dtos := make([]*show.Achievement, 0, len(achievement.Badges))
+	for _, b := range achievement.Badges {
+		dto := &show.Achievement{
+			Code:        b.Code,
+			Name:        b.Name,
+			Description: b.Description,
+			Progress:    min(metrics[b.Metric], b.Target),
+			Target:      b.Target,
+			Reward:      b.Reward,
+		}
+		if a, ok := earned[b.Code]; ok {
+			dto.Earned = true
+			dto.Progress = b.Target
+			dto.EarnTime = a.CreateTime.Unix()
+		}
+		dtos = append(dtos, dto)
+	}
+	return &show.ListAchievementsResp{
+		Code:         0,
+		Msg:          "success",
+		Achievements: dtos,
+	}, nil
+}
+
+// Subscribe 订阅会影响徽章进度的事件, 服务启动时调用
+func (s *AchievementService) Subscribe() {
+	s.Bus.Subscribe(s.handle, event.EssayEvaluated, event.ExerciseSubmitted, event.DailyAttended, event.InvitationAccepted)
+}
+
+// handle 根据事件更新指标, 并检查受影响的徽章
+func (s *AchievementService) handle(ctx context.Context, e *event.Event) error {
+	switch e.Type {
+	case event.EssayEvaluated:
+		if err := s.update(ctx, e.UserId, achievement.MetricEvaluateCount, s.ProgressMapper.Incr, 1); err != nil {
+			return err
+		}
+		if e.Value < 0 {
+			return nil
+		}
+		// 与上一次批改的总分比较
+		last, err := s.ProgressMapper.Swap(ctx, e.UserId, achievement.MetricLastScore, e.Value)
+		if errors.Is(err, consts.ErrNotFound) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		score, ok := last.Metrics[achievement.MetricLastScore]
+		if !ok || e.Value <= score {
+			return nil
+		}
+		return s.update(ctx, e.UserId, achievement.MetricScoreImprovement, s.ProgressMapper.Max, e.Value-score)
+	case event.ExerciseSubmitted:
+		return s.update(ctx, e.UserId, achievement.MetricExerciseCorrect, s.ProgressMapper.Incr, e.Value)
+	case event.DailyAttended:
+		now := s.Clock.Now()
+		as, err := s.AttendMapper.FindBetween(ctx, e.UserId, now.AddDate(0, 0, -streakWindow), now.AddDate(0, 0, 1))
+		if err != nil {
+			return err
+		}
+		return s.update(ctx, e.UserId, achievement.MetricAttendStreak, s.ProgressMapper.Max, attend.LongestStreak(as, now.Location()))
+	case event.InvitationAccepted:
+		return s.update(ctx, e.UserId, achievement.MetricInvitationCount, s.ProgressMapper.Incr, 1)
+	default:
+		return nil
+	}
+}
+
+// update 更新一项指标, 并发放该指标下所有已达成的徽章
+// 通过唯一索引保证同一徽章只发放和奖励一次
+func (s *AchievementService) update(ctx context.Context, userId, metric string,
+	op func(ctx context.Context, userId, metric string, value int64) (*achievement.Progress, error), value int64) error {
+	p, err := op(ctx, userId, metric, value)
+	if err != nil {
+		return err
+	}
+	for _, b := range achievement.Badges {
+		if b.Metric != metric || p.Metrics[metric] < b.Target {
+			continue
+		}
+		ok, err := s.AchievementMapper.Insert(ctx, &achievement.Achievement{UserId: userId, Badge: b.Code})
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		s.Bus.Publish(ctx, &event.Event{Type: event.AchievementEarned, UserId: userId})
+		if b.Reward <= 0 {
+			continue
+		}
+		if _, err = s.QuotaService.Change(ctx, userId, b.Reward, ledger.ReasonAchievement, b.Code); err != nil {
+			logx.CtxError(ctx, "achievement: reward %s to %s error %v", b.Code, userId, err)
+		}
+	}
+	return nil
+}
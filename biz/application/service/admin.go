@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/audit"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/invitation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/session"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"strconv"
+	"time"
+)
+
+type IAdminService interface {
+	SearchUsers(ctx context.Context, req *show.SearchUsersReq) (*show.SearchUsersResp, error)
+	GetUserDetail(ctx context.Context, req *show.GetUserDetailReq) (*show.GetUserDetailResp, error)
+	AdjustQuota(ctx context.Context, req *show.AdjustQuotaReq) (*show.AdjustQuotaResp, error)
+	BanUser(ctx context.Context, req *show.BanUserReq) (*show.Response, error)
+	ForceLogout(ctx context.Context, req *show.ForceLogoutReq) (*show.Response, error)
+	ListAuditLogs(ctx context.Context, req *show.ListAuditLogsReq) (*show.ListAuditLogsResp, error)
+	Record(ctx context.Context, adminId, action, request string, status int)
+	RevokeTime(ctx context.Context, userId string) (int64, error)
+	Status(ctx context.Context, userId string) (int, error)
+}
+
+// AdminService 提供运营人员管理用户的接口, 管理员的每次操作都会记入审计日志
+type AdminService struct {
+	UserMapper          *user.MongoMapper
+	EvaluateLogMapper   *log.MongoMapper
+	CodeMapper          *invitation.CodeMongoMapper
+	InvitationMapper    *invitation.LogMongoMapper
+	AuditMapper         *audit.MongoMapper
+	SessionMapper       *session.RedisMapper
+	QuotaService        IQuotaService
+	PlanService         IPlanService
+	NotificationService INotificationService
+	Clock               clock.Clock
+}
+
+var AdminServiceSet = wire.NewSet(
+	wire.Struct(new(AdminService), "*"),
+	wire.Bind(new(IAdminService), new(*AdminService)),
+)
+
+// checkAdmin 校验调用者是否为管理员, 返回调用者的用户id
+// 管理员路由组的策略中间件已校验过角色, 配置中的管理员始终视为管理员
+func checkAdmin(ctx context.Context) (string, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return "", consts.ErrNotAuthentication
+	}
+	if adaptor.ExtractRole(ctx) != user.RoleAdmin && !util.Contains(config.GetConfig().Admins, meta.GetUserId()) {
+		return "", consts.ErrForbidden
+	}
+	return meta.GetUserId(), nil
+}
+
+// SearchUsers 按手机号、用户名或学校搜索用户
+func (s *AdminService) SearchUsers(ctx context.Context, req *show.SearchUsersReq) (*show.SearchUsersResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	us, total, err := s.UserMapper.Search(ctx, req.Keyword, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.AdminUser, 0, len(us))
+	for _, u := range us {
+		dtos = append(dtos, adminUser(u))
+	}
+	return &show.SearchUsersResp{
+		Code:  0,
+		Msg:   "success",
+		Users: dtos,
+		Total: total,
+	}, nil
+}
+
+// GetUserDetail 查看用户的资料、剩余次数、套餐、批改次数和邀请情况
+func (s *AdminService) GetUserDetail(ctx context.Context, req *show.GetUserDetailReq) (*show.GetUserDetailResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	u, err := s.UserMapper.FindOne(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	resp := &show.GetUserDetailResp{
+		Code: 0,
+		Msg:  "success",
+		User: adminUser(u),
+	}
+	if resp.Plan, resp.Entitlements, err = s.PlanService.Entitlements(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if resp.EvaluateCount, err = s.EvaluateLogMapper.Count(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	// 邀请情况
+	c, err := s.CodeMapper.FindOneByUserId(ctx, req.UserId)
+	if err == nil {
+		resp.InvitationCode = c.Code
+	} else if !errors.Is(err, consts.ErrNotFound) {
+		return nil, err
+	}
+	l, err := s.InvitationMapper.FindOneByInvitee(ctx, req.UserId)
+	if err == nil {
+		resp.Inviter = l.Inviter
+	} else if !errors.Is(err, consts.ErrNotFound) {
+		return nil, err
+	}
+	if resp.Invitees, err = s.InvitationMapper.CountByInviterSince(ctx, req.UserId, time.Time{}); err != nil {
+		return nil, err
+	}
+	if resp.ActivatedInvitees, err = s.InvitationMapper.CountActivated(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AdjustQuota 手动增减用户的剩余批改次数, 调整原因记入账本
+func (s *AdminService) AdjustQuota(ctx context.Context, req *show.AdjustQuotaReq) (*show.AdjustQuotaResp, error) {
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Delta == 0 || req.Reason == "" {
+		return nil, consts.ErrInvalidParams
+	}
+
+	balance, err := s.QuotaService.Adjust(ctx, req.UserId, req.Delta, adminId, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if req.Delta > 0 {
+		if err = s.NotificationService.Notify(ctx, &notification.Notification{
+			UserId:  req.UserId,
+			Type:    notification.TypeQuotaGranted,
+			Title:   "批改次数已到账",
+			Content: "你获得了" + strconv.FormatInt(req.Delta, 10) + "次批改次数：" + req.Reason,
+		}); err != nil {
+			logx.CtxError(ctx, "admin: notify quota of %s error %v", req.UserId, err)
+		}
+	}
+	return &show.AdjustQuotaResp{
+		Code:    0,
+		Msg:     "success",
+		Balance: balance,
+	}, nil
+}
+
+// BanUser 封禁或解封用户, 封禁后用户无法登录且已签发的token立即失效
+func (s *AdminService) BanUser(ctx context.Context, req *show.BanUserReq) (*show.Response, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	status := consts.EffectStatus
+	if req.Ban {
+		status = consts.BanStatus
+	}
+	if err := s.UserMapper.UpdateStatus(ctx, req.UserId, status); err != nil {
+		return nil, err
+	}
+	if req.Ban {
+		if err := s.SessionMapper.Revoke(ctx, req.UserId, s.Clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+	return util.Succeed("success")
+}
+
+// ForceLogout 强制用户下线, 此前签发的token全部失效
+func (s *AdminService) ForceLogout(ctx context.Context, req *show.ForceLogoutReq) (*show.Response, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.UserMapper.FindOne(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if err := s.SessionMapper.Revoke(ctx, req.UserId, s.Clock.Now()); err != nil {
+		return nil, err
+	}
+	return util.Succeed("success")
+}
+
+// ListAuditLogs 分页获取管理员操作的审计日志
+func (s *AdminService) ListAuditLogs(ctx context.Context, req *show.ListAuditLogsReq) (*show.ListAuditLogsResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	ls, total, err := s.AuditMapper.FindMany(ctx, req.GetAdminId(), req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.AuditLog, 0, len(ls))
+	for _, l := range ls {
+		dtos = append(dtos, &show.AuditLog{
+			Id:         l.ID.Hex(),
+			AdminId:    l.AdminId,
+			Action:     l.Action,
+			Request:    l.Request,
+			Status:     int64(l.Status),
+			CreateTime: l.CreateTime.Unix(),
+		})
+	}
+	return &show.ListAuditLogsResp{
+		Code:  0,
+		Msg:   "success",
+		Logs:  dtos,
+		Total: total,
+	}, nil
+}
+
+// Record 记录一次管理员操作, 供管理员路由组的审计中间件使用
+func (s *AdminService) Record(ctx context.Context, adminId, action, request string, status int) {
+	if err := s.AuditMapper.Insert(ctx, &audit.Log{
+		AdminId: adminId,
+		Action:  action,
+		Request: request,
+		Status:  status,
+	}); err != nil {
+		logx.CtxError(ctx, "audit: record %s by %s error %v", action, adminId, err)
+	}
+}
+
+// RevokeTime 查询用户被强制下线的时间戳, 供会话中间件使用
+func (s *AdminService) RevokeTime(ctx context.Context, userId string) (int64, error) {
+	return s.SessionMapper.RevokeTime(ctx, userId)
+}
+
+// Status 查询用户的账号状态, 供会话中间件拦截已封禁的用户
+func (s *AdminService) Status(ctx context.Context, userId string) (int, error) {
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil {
+		return 0, err
+	}
+	return u.Status, nil
+}
+
+func adminUser(u *user.User) *show.AdminUser {
+	return &show.AdminUser{
+		Id:         u.ID.Hex(),
+		Name:       u.Username,
+		Phone:      u.Phone,
+		School:     u.School,
+		Grade:      u.Grade,
+		Count:      u.Count,
+		Status:     int64(u.Status),
+		Role:       roleOf(u),
+		CreateTime: u.CreateTime.Unix(),
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+	"testing"
+	"time"
+)
+
+// nopRankService 签到测试不关心榜单
+type nopRankService struct {
+	IRankService
+}
+
+func (nopRankService) OnAttend(context.Context, string) {}
+
+// attendTest 以指定用户的身份在固定时间签到, 需要真实的MongoDB和Redis
+type attendTest struct {
+	t    *testing.T
+	s    *UserService
+	sign func(userId string) string
+}
+
+func newAttendTest(t *testing.T) *attendTest {
+	c := testutil.LoadDBConfig(t)
+	c.Attend.StreakRewards = []config.StreakReward{{Days: 3, Reward: 5}}
+	sign := testutil.SignToken(t, c)
+
+	userMapper := user.NewMongoMapper(c)
+	return &attendTest{t: t, sign: sign, s: &UserService{
+		UserMapper:   userMapper,
+		AttendMapper: attend.NewMongoMapper(c),
+		QuotaService: &QuotaService{UserMapper: userMapper, LedgerMapper: ledger.NewMongoMapper(c)},
+		RankService:  nopRankService{},
+		Bus:          event.NewBus(),
+	}}
+}
+
+// user 创建一个有指定补签卡数量的用户
+func (at *attendTest) user(cards int64) string {
+	at.t.Helper()
+	u := &user.User{Username: "attend-test", MakeUpCard: cards}
+	if err := at.s.UserMapper.Insert(context.Background(), u); err != nil {
+		at.t.Fatal(err)
+	}
+	return u.ID.Hex()
+}
+
+// ctx 返回以用户身份发起请求的上下文, 并将时钟停在now
+func (at *attendTest) ctx(userId string, now time.Time) context.Context {
+	at.s.Clock = clock.Fixed(now)
+	c := app.NewContext(0)
+	c.Request.Header.Set("Authorization", at.sign(userId))
+	return adaptor.InjectContext(context.Background(), c)
+}
+
+// attend 在now签到并检查结果和最新的连续签到天数, streak为0时期望当天重复签到
+func (at *attendTest) attend(userId string, now time.Time, streak int64) {
+	at.t.Helper()
+	ctx := at.ctx(userId, now)
+	_, err := at.s.DailyAttend(ctx, &show.DailyAttendReq{})
+	if streak == 0 {
+		if !errors.Is(err, consts.ErrRepeatDailyAttend) {
+			at.t.Fatalf("attend at %v error %v, want ErrRepeatDailyAttend", now, err)
+		}
+		return
+	}
+	if err != nil {
+		at.t.Fatalf("attend at %v error %v", now, err)
+	}
+	at.streak(userId, streak)
+}
+
+func (at *attendTest) streak(userId string, want int64) {
+	at.t.Helper()
+	a, err := at.s.AttendMapper.FindLatestOneByUserId(context.Background(), userId)
+	if err != nil {
+		at.t.Fatal(err)
+	}
+	if a.Streak != want {
+		at.t.Fatalf("streak = %d on %s, want %d", a.Streak, a.Date, want)
+	}
+}
+
+func (at *attendTest) count(userId string, want int64) {
+	at.t.Helper()
+	u, err := at.s.UserMapper.FindOne(context.Background(), userId)
+	if err != nil {
+		at.t.Fatal(err)
+	}
+	if u.Count != want {
+		at.t.Fatalf("count = %d, want %d", u.Count, want)
+	}
+}
+
+var newYork = mustLoad("America/New_York")
+
+func mustLoad(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// 业务时区的零点前后属于不同的两天, 与UTC的日期无关
+func TestDailyAttendMidnight(t *testing.T) {
+	at := newAttendTest(t)
+	shanghai := mustLoad("Asia/Shanghai")
+	u := at.user(0)
+
+	at.attend(u, time.Date(2026, 3, 1, 23, 59, 59, 0, shanghai), 1)
+	at.attend(u, time.Date(2026, 3, 1, 23, 59, 59, 0, shanghai), 0)
+	// 北京时间零点是UTC前一天的16点
+	at.attend(u, time.Date(2026, 3, 2, 0, 0, 0, 0, shanghai), 2)
+	at.attend(u, time.Date(2026, 3, 2, 15, 59, 59, 0, time.UTC).In(shanghai), 0)
+	// 第3天达到里程碑, 额外奖励5次
+	at.attend(u, time.Date(2026, 3, 2, 16, 0, 0, 0, time.UTC).In(shanghai), 3)
+	at.count(u, 3+5)
+	// 漏签一天后重新开始
+	at.attend(u, time.Date(2026, 3, 5, 8, 0, 0, 0, shanghai), 1)
+}
+
+// 夏令时切换当天只有23或25小时, 连续签到仍按自然日计算
+func TestDailyAttendDST(t *testing.T) {
+	at := newAttendTest(t)
+
+	// 2026-03-08 02:00 开始夏令时, 两次签到只相隔23小时
+	spring := at.user(0)
+	at.attend(spring, time.Date(2026, 3, 7, 23, 30, 0, 0, newYork), 1)
+	at.attend(spring, time.Date(2026, 3, 8, 23, 30, 0, 0, newYork), 2)
+	at.attend(spring, time.Date(2026, 3, 8, 23, 59, 0, 0, newYork), 0)
+	at.attend(spring, time.Date(2026, 3, 9, 0, 0, 0, 0, newYork), 3)
+
+	// 2026-11-01 02:00 结束夏令时, 当天的00:30和23:30相隔24小时仍是同一天
+	fall := at.user(0)
+	at.attend(fall, time.Date(2026, 10, 31, 23, 30, 0, 0, newYork), 1)
+	at.attend(fall, time.Date(2026, 11, 1, 0, 30, 0, 0, newYork), 2)
+	at.attend(fall, time.Date(2026, 11, 1, 23, 30, 0, 0, newYork), 0)
+	at.attend(fall, time.Date(2026, 11, 2, 0, 0, 0, 0, newYork), 3)
+}
+
+// 补签漏签的一天后合并前后的连续签到, 并补发跨过的里程碑奖励
+func TestMakeUpAttend(t *testing.T) {
+	at := newAttendTest(t)
+	u := at.user(1)
+
+	// 3月8日开始夏令时, 漏签当天
+	at.attend(u, time.Date(2026, 3, 7, 23, 30, 0, 0, newYork), 1)
+	at.attend(u, time.Date(2026, 3, 9, 0, 10, 0, 0, newYork), 1)
+	at.count(u, 2)
+
+	makeUp := func(now time.Time, day int32) error {
+		_, err := at.s.MakeUpAttend(at.ctx(u, now), &show.MakeUpAttendReq{Year: 2026, Month: 3, Day: day})
+		return err
+	}
+	// 不能补签今天
+	if err := makeUp(time.Date(2026, 3, 9, 0, 20, 0, 0, newYork), 9); !errors.Is(err, consts.ErrMakeUp) {
+		t.Fatalf("make up today error %v, want ErrMakeUp", err)
+	}
+	if err := makeUp(time.Date(2026, 3, 9, 0, 20, 0, 0, newYork), 8); err != nil {
+		t.Fatal(err)
+	}
+	at.streak(u, 3)
+	at.count(u, 2+5)
+
+	// 已签到的日期不能补签, 补签卡用完后不能再补签
+	if err := makeUp(time.Date(2026, 3, 9, 0, 30, 0, 0, newYork), 8); !errors.Is(err, consts.ErrMakeUp) {
+		t.Fatalf("make up twice error %v, want ErrMakeUp", err)
+	}
+	if err := makeUp(time.Date(2026, 3, 9, 0, 30, 0, 0, newYork), 6); !errors.Is(err, consts.ErrNoMakeUpCard) {
+		t.Fatalf("make up without card error %v, want ErrNoMakeUpCard", err)
+	}
+}
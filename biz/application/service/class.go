@@ -0,0 +1,498 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/class"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	"sort"
+	"time"
+)
+
+type IClassService interface {
+	CreateClass(ctx context.Context, req *show.CreateClassReq) (*show.CreateClassResp, error)
+	ListClasses(ctx context.Context, req *show.ListClassesReq) (*show.ListClassesResp, error)
+	JoinClass(ctx context.Context, req *show.JoinClassReq) (*show.JoinClassResp, error)
+	LeaveClass(ctx context.Context, req *show.LeaveClassReq) (*show.Response, error)
+	ListClassMembers(ctx context.Context, req *show.ListClassMembersReq) (*show.ListClassMembersResp, error)
+	RemoveClassMember(ctx context.Context, req *show.RemoveClassMemberReq) (*show.Response, error)
+	CreateAssignment(ctx context.Context, req *show.CreateAssignmentReq) (*show.CreateAssignmentResp, error)
+	ListAssignments(ctx context.Context, req *show.ListAssignmentsReq) (*show.ListAssignmentsResp, error)
+	GetAssignmentReport(ctx context.Context, req *show.GetAssignmentReportReq) (*show.GetAssignmentReportResp, error)
+	CheckSubmission(ctx context.Context, userId, assignmentId string) (*class.Assignment, error)
+	Teaches(ctx context.Context, teacherId, studentId string) (bool, error)
+}
+
+// ClassService 管理教师的班级和作业, 学生填写班级码加入班级, 提交批改时关联到作业
+// 教师只能管理自己创建的班级, 管理员可以管理所有班级
+type ClassService struct {
+	ClassMapper       *class.ClassMongoMapper
+	MemberMapper      *class.MemberMongoMapper
+	AssignmentMapper  *class.AssignmentMongoMapper
+	UserMapper        *user.MongoMapper
+	EvaluateLogMapper *log.MongoMapper
+	Clock             clock.Clock
+}
+
+var ClassServiceSet = wire.NewSet(
+	wire.Struct(new(ClassService), "*"),
+	wire.Bind(new(IClassService), new(*ClassService)),
+)
+
+// CreateClass 教师创建班级并获得班级码
+func (s *ClassService) CreateClass(ctx context.Context, req *show.CreateClassReq) (*show.CreateClassResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	if req.Name == "" {
+		return nil, consts.ErrInvalidParams
+	}
+
+	c := &class.Class{
+		TeacherId: meta.GetUserId(),
+		Name:      req.Name,
+		School:    req.School,
+		Grade:     req.Grade,
+	}
+	if err := s.ClassMapper.Insert(ctx, c); err != nil {
+		return nil, err
+	}
+	return &show.CreateClassResp{
+		Code:  0,
+		Msg:   "创建成功",
+		Class: classDTO(c, 0),
+	}, nil
+}
+
+// ListClasses 获取自己创建的班级和加入的班级
+func (s *ClassService) ListClasses(ctx context.Context, req *show.ListClassesReq) (*show.ListClassesResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	teaching, err := s.ClassMapper.FindManyByTeacher(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	mbs, err := s.MemberMapper.FindManyByUserId(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(mbs))
+	for _, mb := range mbs {
+		ids = append(ids, mb.ClassId)
+	}
+	joined, err := s.ClassMapper.FindManyByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &show.ListClassesResp{Code: 0, Msg: "success"}
+	if resp.Teaching, err = s.classDTOs(ctx, teaching); err != nil {
+		return nil, err
+	}
+	if resp.Joined, err = s.classDTOs(ctx, joined); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// JoinClass 学生填写班级码加入班级
+func (s *ClassService) JoinClass(ctx context.Context, req *show.JoinClassReq) (*show.JoinClassResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	c, err := s.ClassMapper.FindOneByCode(ctx, req.Code)
+	if errors.Is(err, consts.ErrNotFound) {
+		return nil, consts.ErrClassCode
+	}
+	if err != nil {
+		return nil, err
+	}
+	if c.TeacherId == meta.GetUserId() {
+		return nil, consts.ErrClassJoined
+	}
+	n, err := s.MemberMapper.Count(ctx, c.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if n >= config.GetConfig().Class.MaxStudents {
+		return nil, consts.ErrClassFull
+	}
+	if err = s.MemberMapper.Insert(ctx, &class.Member{ClassId: c.ID.Hex(), UserId: meta.GetUserId()}); err != nil {
+		return nil, err
+	}
+	return &show.JoinClassResp{
+		Code:  0,
+		Msg:   "加入成功",
+		Class: classDTO(c, n+1),
+	}, nil
+}
+
+// LeaveClass 学生退出班级
+func (s *ClassService) LeaveClass(ctx context.Context, req *show.LeaveClassReq) (*show.Response, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	if err := s.MemberMapper.Delete(ctx, req.ClassId, meta.GetUserId()); err != nil {
+		if errors.Is(err, consts.ErrNotFound) {
+			return nil, consts.ErrNotClassMember
+		}
+		return nil, err
+	}
+	return util.Succeed("已退出班级")
+}
+
+// ListClassMembers 教师查看班级的学生
+func (s *ClassService) ListClassMembers(ctx context.Context, req *show.ListClassMembersReq) (*show.ListClassMembersResp, error) {
+	c, err := s.owned(ctx, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	mbs, err := s.MemberMapper.FindManyByClass(ctx, c.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.members(ctx, mbs)
+	if err != nil {
+		return nil, err
+	}
+	return &show.ListClassMembersResp{
+		Code:    0,
+		Msg:     "success",
+		Members: members,
+	}, nil
+}
+
+// RemoveClassMember 教师将学生移出班级, 学生已提交的批改记录仍然保留
+func (s *ClassService) RemoveClassMember(ctx context.Context, req *show.RemoveClassMemberReq) (*show.Response, error) {
+	c, err := s.owned(ctx, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.MemberMapper.Delete(ctx, c.ID.Hex(), req.UserId); err != nil {
+		if errors.Is(err, consts.ErrNotFound) {
+			return nil, consts.ErrNotClassMember
+		}
+		return nil, err
+	}
+	return util.Succeed("已移出班级")
+}
+
+// CreateAssignment 教师在班级中布置作业
+func (s *ClassService) CreateAssignment(ctx context.Context, req *show.CreateAssignmentReq) (*show.CreateAssignmentResp, error) {
+	c, err := s.owned(ctx, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Unix(req.Deadline, 0)
+	if req.Title == "" || !deadline.After(s.Clock.Now()) {
+		return nil, consts.ErrInvalidParams
+	}
+
+	grade := req.Grade
+	if grade == 0 {
+		grade = c.Grade
+	}
+	a := &class.Assignment{
+		ClassId:   c.ID.Hex(),
+		TeacherId: c.TeacherId,
+		Title:     req.Title,
+		Prompt:    req.Prompt,
+		EssayType: req.EssayType,
+		Grade:     grade,
+		Deadline:  deadline,
+	}
+	if err = s.AssignmentMapper.Insert(ctx, a); err != nil {
+		return nil, err
+	}
+	return &show.CreateAssignmentResp{
+		Code:       0,
+		Msg:        "布置成功",
+		Assignment: assignmentDTO(a),
+	}, nil
+}
+
+// ListAssignments 分页获取班级的作业, 教师和班级中的学生都可以查看, 学生查看时附带自己最近一次提交
+func (s *ClassService) ListAssignments(ctx context.Context, req *show.ListAssignmentsReq) (*show.ListAssignmentsResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	c, err := s.ClassMapper.FindOne(ctx, req.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	teacher := c.TeacherId == meta.GetUserId()
+	if !teacher {
+		if _, err = s.MemberMapper.FindOne(ctx, c.ID.Hex(), meta.GetUserId()); err != nil {
+			if errors.Is(err, consts.ErrNotFound) {
+				return nil, consts.ErrNotClassMember
+			}
+			return nil, err
+		}
+	}
+
+	as, total, err := s.AssignmentMapper.FindManyByClass(ctx, c.ID.Hex(), req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.Assignment, 0, len(as))
+	ids := make([]string, 0, len(as))
+	for _, a := range as {
+		dtos = append(dtos, assignmentDTO(a))
+		ids = append(ids, a.ID.Hex())
+	}
+	if !teacher && len(ids) > 0 {
+		logs, err := s.EvaluateLogMapper.FindManyByUserAndAssignments(ctx, meta.GetUserId(), ids)
+		if err != nil {
+			return nil, err
+		}
+		// 记录按提交时间正序, 后出现的覆盖先出现的
+		latest := make(map[string]string, len(logs))
+		for _, l := range logs {
+			latest[l.Assignment] = l.ID.Hex()
+		}
+		for _, dto := range dtos {
+			dto.LogId = latest[dto.Id]
+		}
+	}
+	return &show.ListAssignmentsResp{
+		Code:        0,
+		Msg:         "success",
+		Assignments: dtos,
+		Total:       total,
+	}, nil
+}
+
+// GetAssignmentReport 教师查看作业的提交情况、分数分布和常见问题, 每名学生只统计最近一次提交
+func (s *ClassService) GetAssignmentReport(ctx context.Context, req *show.GetAssignmentReportReq) (*show.GetAssignmentReportResp, error) {
+	a, err := s.AssignmentMapper.FindOne(ctx, req.AssignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.owned(ctx, a.ClassId); err != nil {
+		return nil, err
+	}
+	mbs, err := s.MemberMapper.FindManyByClass(ctx, a.ClassId)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.members(ctx, mbs)
+	if err != nil {
+		return nil, err
+	}
+	logs, err := s.EvaluateLogMapper.FindManyByAssignment(ctx, a.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]*log.Log, len(logs))
+	for _, l := range logs {
+		latest[l.UserId] = l
+	}
+
+	c := config.GetConfig().Class
+	resp := &show.GetAssignmentReportResp{
+		Code:         0,
+		Msg:          "success",
+		Assignment:   assignmentDTO(a),
+		MemberCount:  int64(len(members)),
+		Distribution: make([]*show.ScoreBucket, 0),
+		Issues:       make([]*show.IssueCount, 0),
+		Submissions:  make([]*show.Submission, 0),
+		Missing:      make([]*show.ClassMember, 0),
+	}
+	buckets := make(map[int64]int64)
+	issues := make(map[string]int64)
+	var sum, scored int64
+	// 只统计仍在班级中的学生
+	for _, m := range members {
+		l, ok := latest[m.UserId]
+		if !ok {
+			resp.Missing = append(resp.Missing, m)
+			continue
+		}
+		score, ok := l.Score()
+		if !ok {
+			score = -1
+		}
+		resp.Submissions = append(resp.Submissions, &show.Submission{
+			UserId:     m.UserId,
+			Name:       m.Name,
+			LogId:      l.ID.Hex(),
+			Score:      score,
+			SubmitTime: l.CreateTime.Unix(),
+		})
+		for _, i := range l.Issues() {
+			issues[i]++
+		}
+		if score < 0 {
+			continue
+		}
+		if scored == 0 || score > resp.HighestScore {
+			resp.HighestScore = score
+		}
+		if scored == 0 || score < resp.LowestScore {
+			resp.LowestScore = score
+		}
+		sum += score
+		scored++
+		buckets[score/c.ScoreBucket]++
+	}
+	resp.SubmittedCount = int64(len(resp.Submissions))
+	if scored > 0 {
+		resp.AverageScore = sum / scored
+	}
+
+	for b, n := range buckets {
+		resp.Distribution = append(resp.Distribution, &show.ScoreBucket{Min: b * c.ScoreBucket, Max: (b + 1) * c.ScoreBucket, Count: n})
+	}
+	sort.Slice(resp.Distribution, func(i, j int) bool { return resp.Distribution[i].Min < resp.Distribution[j].Min })
+	for i, n := range issues {
+		resp.Issues = append(resp.Issues, &show.IssueCount{Issue: i, Count: n})
+	}
+	sort.Slice(resp.Issues, func(i, j int) bool {
+		if resp.Issues[i].Count != resp.Issues[j].Count {
+			return resp.Issues[i].Count > resp.Issues[j].Count
+		}
+		return resp.Issues[i].Issue < resp.Issues[j].Issue
+	})
+	resp.Issues = resp.Issues[:min(int64(len(resp.Issues)), c.TopIssues)]
+	return resp, nil
+}
+
+// CheckSubmission 校验学生是否可以向作业提交作文
+func (s *ClassService) CheckSubmission(ctx context.Context, userId, assignmentId string) (*class.Assignment, error) {
+	a, err := s.AssignmentMapper.FindOne(ctx, assignmentId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.MemberMapper.FindOne(ctx, a.ClassId, userId); err != nil {
+		if errors.Is(err, consts.ErrNotFound) {
+			return nil, consts.ErrNotClassMember
+		}
+		return nil, err
+	}
+	if !a.Open(s.Clock.Now()) {
+		return nil, consts.ErrAssignmentClosed
+	}
+	return a, nil
+}
+
+// Teaches 判断学生是否在教师创建的某个班级中
+func (s *ClassService) Teaches(ctx context.Context, teacherId, studentId string) (bool, error) {
+	mbs, err := s.MemberMapper.FindManyByUserId(ctx, studentId)
+	if err != nil {
+		return false, err
+	}
+	ids := make([]string, 0, len(mbs))
+	for _, mb := range mbs {
+		ids = append(ids, mb.ClassId)
+	}
+	cs, err := s.ClassMapper.FindManyByIds(ctx, ids)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range cs {
+		if c.TeacherId == teacherId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// owned 获取调用者有权管理的班级, 只有创建班级的教师和管理员可以管理
+func (s *ClassService) owned(ctx context.Context, classId string) (*class.Class, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	c, err := s.ClassMapper.FindOne(ctx, classId)
+	if err != nil {
+		return nil, err
+	}
+	if c.TeacherId != meta.GetUserId() && adaptor.ExtractRole(ctx) != user.RoleAdmin {
+		return nil, consts.ErrForbidden
+	}
+	return c, nil
+}
+
+// members 查询班级学生的用户信息, 已不存在的用户会被跳过
+func (s *ClassService) members(ctx context.Context, mbs []*class.Member) ([]*show.ClassMember, error) {
+	ids := make([]string, 0, len(mbs))
+	for _, mb := range mbs {
+		ids = append(ids, mb.UserId)
+	}
+	us, err := s.UserMapper.FindManyByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[string]*user.User, len(us))
+	for _, u := range us {
+		byId[u.ID.Hex()] = u
+	}
+	dtos := make([]*show.ClassMember, 0, len(mbs))
+	for _, mb := range mbs {
+		if u, ok := byId[mb.UserId]; ok {
+			dtos = append(dtos, &show.ClassMember{
+				UserId:   mb.UserId,
+				Name:     u.Username,
+				Avatar:   u.Avatar,
+				JoinTime: mb.CreateTime.Unix(),
+			})
+		}
+	}
+	return dtos, nil
+}
+
+func (s *ClassService) classDTOs(ctx context.Context, cs []*class.Class) ([]*show.Class, error) {
+	dtos := make([]*show.Class, 0, len(cs))
+	for _, c := range cs {
+		n, err := s.MemberMapper.Count(ctx, c.ID.Hex())
+		if err != nil {
+			return nil, err
+		}
+		dtos = append(dtos, classDTO(c, n))
+	}
+	return dtos, nil
+}
+
+func classDTO(c *class.Class, n int64) *show.Class {
+	return &show.Class{
+		Id:          c.ID.Hex(),
+		Name:        c.Name,
+		Code:        c.Code,
+		School:      c.School,
+		Grade:       c.Grade,
+		TeacherId:   c.TeacherId,
+		MemberCount: n,
+		CreateTime:  c.CreateTime.Unix(),
+	}
+}
+
+func assignmentDTO(a *class.Assignment) *show.Assignment {
+	return &show.Assignment{
+		Id:         a.ID.Hex(),
+		ClassId:    a.ClassId,
+		Title:      a.Title,
+		Prompt:     a.Prompt,
+		EssayType:  a.EssayType,
+		Grade:      a.Grade,
+		Deadline:   a.Deadline.Unix(),
+		CreateTime: a.CreateTime.Unix(),
+	}
+}
@@ -7,11 +7,17 @@ import (
 	"github.com/jinzhu/copier"
 	"github.com/xh-polaris/essay-show/biz/adaptor"
 	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/review"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/analysis"
 	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
@@ -22,8 +28,16 @@ type IEssayService interface {
 }
 
 type EssayService struct {
-	LogMapper  *log.MongoMapper
-	UserMapper *user.MongoMapper
+	LogMapper         *log.MongoMapper
+	UserMapper        *user.MongoMapper
+	ReviewMapper      *review.MongoMapper
+	Bus               *event.Bus
+	QuotaService      IQuotaService
+	PlanService       IPlanService
+	RelationService   IRelationService
+	ClassService      IClassService
+	PromptService     IPromptService
+	ModerationService IModerationService
 }
 
 var EssayServiceSet = wire.NewSet(
@@ -47,9 +61,63 @@ func (s *EssayService) EssayEvaluate(ctx context.Context, req *show.EssayEvaluat
 		return nil, consts.ErrNotFound
 	}
 
-	// 剩余次数不足
+	// 本地分析作文, 空白或字数过少的作文不予批改, 不消耗次数
+	a := analysis.Analyze(req.Text)
+	if a.CharCount == 0 {
+		return nil, consts.ErrEssayEmpty
+	}
+	if a.WordCount < config.GetConfig().Analysis.MinWords {
+		return nil, consts.ErrEssayTooShort
+	}
+
+	// 审核作文内容, 被拒绝的提交会保存下来供复核, 不消耗次数
+	if err = s.ModerationService.Screen(ctx, meta.GetUserId(), req.Title, req.Text); err != nil {
+		return nil, err
+	}
+
+	// 没有可用的套餐权益且剩余次数不足
 	if u.Count <= 0 {
-		return nil, consts.ErrInSufficientCount
+		ok, err := s.PlanService.Available(ctx, u.ID.Hex())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, consts.ErrInSufficientCount
+		}
+	}
+
+	// 提交到班级作业时校验作业, 未指定年级和体裁时使用作业的设置
+	if req.AssignmentId != "" {
+		a, err := s.ClassService.CheckSubmission(ctx, meta.GetUserId(), req.AssignmentId)
+		if err != nil {
+			return nil, err
+		}
+		if req.Grade == nil && a.Grade != 0 {
+			req.Grade = &a.Grade
+		}
+		if req.EssayType == nil && a.EssayType != "" {
+			req.EssayType = &a.EssayType
+		}
+	}
+
+	// 从题库选题时, 未填写的标题、年级和体裁使用题目的设置, 并按题目要求检查字数
+	var check *show.WordCheck
+	if req.PromptId != "" {
+		p, err := s.PromptService.Online(ctx, req.PromptId)
+		if err != nil {
+			return nil, err
+		}
+		if req.Title == "" {
+			req.Title = p.Title
+		}
+		if req.Grade == nil && p.Grade != 0 {
+			req.Grade = &p.Grade
+		}
+		if req.EssayType == nil && p.EssayType != "" {
+			req.EssayType = &p.EssayType
+		}
+		// 与最少字数的检查使用同一口径
+		check = &show.WordCheck{WordCount: a.WordCount, MinWords: p.MinWords, MaxWords: p.MaxWords, Passed: p.CheckWords(a.WordCount)}
 	}
 
 	// 获取锁
@@ -86,32 +154,49 @@ func (s *EssayService) EssayEvaluate(ctx context.Context, req *show.EssayEvaluat
 		Ocr:        req.Ocr,
 		Response:   result,
 		Status:     int(code),
+		Assignment: req.AssignmentId,
+		Prompt:     req.PromptId,
+		Analysis:   a,
 		CreateTime: time.Now(),
 	}
+	if check != nil {
+		l.WordCount = check.WordCount
+	}
 	if req.Grade != nil {
 		l.Grade = *req.Grade
 	}
 
 	// 批改失败，记录对应的情况
 	if code != 0 {
-		logx.CtxError(ctx, "批改失败 err: %v", err)
-		// 存入错误信息，用于后续分析问题 TODO: 后续可能考虑这里通过定时任务存档，并从数据库中删除
-		l.Response = err.Error()
-		err = s.LogMapper.InsertErr(ctx, l)
+		logx.CtxError(ctx, "批改失败 code: %d, msg: %s", code, msg)
+		// 存入完整的返回结果，用于后续分析问题 TODO: 后续可能考虑这里通过定时任务存档，并从数据库中删除
+		if err = s.LogMapper.InsertErr(ctx, l); err != nil {
+			logx.CtxError(ctx, "err log insert failed %v", err)
+		}
 		return nil, consts.ErrCall
 	}
 
+	// 先分配记录id, 扣次数的账本和返回的id都引用它
+	l.ID = primitive.NewObjectID()
 	resp := &show.EssayEvaluateResp{
-		Code:     code,
-		Msg:      msg,
-		Response: result,
-		Id:       l.ID.Hex(),
+		Code:         code,
+		Msg:          msg,
+		Response:     result,
+		Id:           l.ID.Hex(),
+		WordCheck:    check,
+		TextAnalysis: analysisDTO(a),
 	}
 
-	// 扣除用户剩余次数
-	err = s.UserMapper.UpdateCount(ctx, meta.GetUserId(), -1)
+	// 优先消耗套餐权益, 没有可用权益时扣除用户剩余次数
+	used, err := s.PlanService.Consume(ctx, meta.GetUserId())
 	if err != nil {
-		return nil, err //  扣除失败用户不应该拿到结果
+		return nil, err
+	}
+	if !used {
+		_, err = s.QuotaService.Change(ctx, meta.GetUserId(), -1, ledger.ReasonEvaluate, l.ID.Hex())
+		if err != nil {
+			return nil, err //  扣除失败用户不应该拿到结果
+		}
 	}
 
 	// 存入正确批改结果
@@ -120,19 +205,34 @@ func (s *EssayService) EssayEvaluate(ctx context.Context, req *show.EssayEvaluat
 		// 记录插入失败应该也要获得结果，因为剩余次数已经成功扣除。 TODO: 需要一个托底逻辑，考虑使用事务
 		logx.CtxError(ctx, "log insert failed %v", err)
 	}
+
+	// 发布批改完成事件
+	score, ok := l.Score()
+	if !ok {
+		score = -1
+	}
+	s.Bus.Publish(ctx, &event.Event{Type: event.EssayEvaluated, UserId: meta.GetUserId(), Value: score})
 	return resp, nil
 }
 
 // GetEvaluateLogs 分页查找获取正常的批改记录
 func (s *EssayService) GetEvaluateLogs(ctx context.Context, req *show.GetEssayEvaluateLogsReq) (resp *show.GetEssayEvaluateLogsResp, err error) {
-	// 获取用户信息
-	meta := adaptor.ExtractUserMeta(ctx)
-	if meta.GetUserId() == "" {
-		return nil, consts.ErrNotAuthentication
+	// 获取要查看的用户, 家长可以查看绑定学生的记录
+	userId, err := s.RelationService.Target(ctx, req.StudentId)
+	if err != nil {
+		return nil, err
 	}
 
 	// 分页查询
-	data, total, err := s.LogMapper.FindMany(ctx, meta.GetUserId(), req.PaginationOptions)
+	data, total, err := s.LogMapper.FindMany(ctx, userId, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(data))
+	for _, val := range data {
+		ids = append(ids, val.ID.Hex())
+	}
+	reviewed, err := s.ReviewMapper.FindReviewed(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +245,12 @@ func (s *EssayService) GetEvaluateLogs(ctx context.Context, req *show.GetEssayEv
 			return nil, err
 		}
 		l.Id = val.ID.Hex()
+		l.AssignmentId = val.Assignment
+		l.Reviewed = reviewed[l.Id]
+		l.PromptId = val.Prompt
+		if val.Analysis != nil {
+			l.TextAnalysis = analysisDTO(val.Analysis)
+		}
 		l.CreateTime = val.CreateTime.Unix()
 		logs = append(logs, l)
 	}
@@ -171,3 +277,36 @@ func (s *EssayService) LikeEvaluate(ctx context.Context, req *show.LikeEvaluateR
 	}
 	return util.Succeed("标记成功")
 }
+
+func analysisDTO(a *analysis.Analysis) *show.TextAnalysis {
+	dto := &show.TextAnalysis{
+		CharCount:         a.CharCount,
+		WordCount:         a.WordCount,
+		ParagraphCount:    int64(len(a.Paragraphs)),
+		SentenceCount:     a.SentenceCount(),
+		Paragraphs:        make([]*show.Paragraph, 0, len(a.Paragraphs)),
+		PunctuationIssues: make([]*show.PunctuationIssue, 0, len(a.Punctuation)),
+		RepeatedPhrases:   phraseDTOs(a.RepeatedPhrases),
+		Idioms:            phraseDTOs(a.Idioms),
+	}
+	for _, p := range a.Paragraphs {
+		dto.Paragraphs = append(dto.Paragraphs, &show.Paragraph{Sentences: p})
+	}
+	for _, i := range a.Punctuation {
+		dto.PunctuationIssues = append(dto.PunctuationIssues, &show.PunctuationIssue{
+			Type:      i.Type,
+			Paragraph: i.Paragraph,
+			Sentence:  i.Sentence,
+			Text:      i.Text,
+		})
+	}
+	return dto
+}
+
+func phraseDTOs(ps []*analysis.Phrase) []*show.PhraseCount {
+	dtos := make([]*show.PhraseCount, 0, len(ps))
+	for _, p := range ps {
+		dtos = append(dtos, &show.PhraseCount{Text: p.Text, Count: p.Count})
+	}
+	return dtos
+}
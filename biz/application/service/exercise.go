@@ -6,12 +6,16 @@ import (
 	"github.com/jinzhu/copier"
 	"github.com/xh-polaris/essay-show/biz/adaptor"
 	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/exercise"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
 	eu "github.com/xh-polaris/essay-show/biz/infrastructure/util/exercise"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
 	"golang.org/x/net/context"
 	"time"
 )
@@ -22,12 +26,21 @@ type IExerciseService interface {
 	GetExercise(ctx context.Context, req *show.GetExerciseReq) (resp *show.GetExerciseResp, err error)
 	DoExercise(ctx context.Context, req *show.DoExerciseReq) (resp *show.DoExerciseResp, err error)
 	LikeExercise(ctx context.Context, req *show.LikeExerciseReq) (resp *show.Response, err error)
+	DeleteExercise(ctx context.Context, req *show.DeleteExerciseReq) (resp *show.Response, err error)
+	RegenerateExercise(ctx context.Context, req *show.RegenerateExerciseReq) (resp *show.CreateExerciseResp, err error)
+	ReportQuestion(ctx context.Context, req *show.ReportQuestionReq) (resp *show.Response, err error)
+	ListQuestionReports(ctx context.Context, req *show.ListQuestionReportsReq) (resp *show.ListQuestionReportsResp, err error)
 }
 
 type ExerciseService struct {
-	ExerciseMapper *exercise.MongoMapper
-	LogMapper      *log.MongoMapper
-	UserMapper     *user.MongoMapper
+	ExerciseMapper  *exercise.MongoMapper
+	LogMapper       *log.MongoMapper
+	UserMapper      *user.MongoMapper
+	ReportMapper    *exercise.ReportMongoMapper
+	RankService     IRankService
+	Bus             *event.Bus
+	QuotaService    IQuotaService
+	RelationService IRelationService
 }
 
 var ExerciseServiceSet = wire.NewSet(
@@ -37,64 +50,24 @@ var ExerciseServiceSet = wire.NewSet(
 
 // CreateExercise 创建一套练习
 func (s ExerciseService) CreateExercise(ctx context.Context, req *show.CreateExerciseReq) (resp *show.CreateExerciseResp, err error) {
-	// 获取批改记录
-	l, err := s.LogMapper.FindOne(ctx, req.LogId)
-	if err != nil {
-		return nil, err
-	}
-
 	// 获取用户信息
 	userMeta := adaptor.ExtractUserMeta(ctx)
 	if userMeta.GetUserId() == "" {
 		return nil, consts.ErrNotAuthentication
 	}
-	u, err := s.UserMapper.FindOne(ctx, userMeta.UserId)
-	if err != nil {
-		return nil, err
-	}
-
-	// 调用生成接口
-	e, err := eu.GenerateExercise(ctx, u.Grade, l)
-	if err != nil {
-		return nil, err
-	}
-
-	// 存储练习
-	e.LogId = req.LogId
-	e.UserId = userMeta.UserId
-	err = s.ExerciseMapper.Insert(ctx, e)
-	if err != nil {
-		return nil, err
-	}
-
-	// dto构造
-	dto := &show.Exercise{}
-	err = copier.Copy(dto, e)
-	if err != nil {
-		return nil, err
-	}
-	dto.Id = e.ID.Hex()
-	dto.CreateTime = e.CreateTime.Unix()
-	dto.UpdateTime = e.CreateTime.Unix()
-
-	resp = &show.CreateExerciseResp{
-		Code:     0,
-		Msg:      "success",
-		Exercise: dto,
-	}
-	return
+	return s.create(ctx, userMeta.GetUserId(), req.LogId)
 }
 
 // ListSimpleExercises 获取简要的练习列表
 func (s ExerciseService) ListSimpleExercises(ctx context.Context, req *show.ListSimpleExercisesReq) (resp *show.ListSimpleExercisesResp, err error) {
-	// 获取用户信息
-	userMeta := adaptor.ExtractUserMeta(ctx)
-	if userMeta.GetUserId() == "" {
-		return nil, consts.ErrNotAuthentication
+	// 获取要查看的用户, 家长可以查看绑定学生的练习
+	userId, err := s.RelationService.Target(ctx, req.StudentId)
+	if err != nil {
+		return nil, err
 	}
 
-	// 查询批改记录对应的练习
-	data, total, err := s.ExerciseMapper.FindManyByLogId(ctx, req.LogId, req.PaginationOptions)
+	// 查询用户在该批改记录下的练习
+	data, total, err := s.ExerciseMapper.FindManyByLogId(ctx, userId, req.LogId, req.PaginationOptions)
 	if err != nil && !errors.Is(err, consts.ErrNotFound) {
 		return nil, err
 	}
@@ -126,7 +99,7 @@ func (s ExerciseService) ListSimpleExercises(ctx context.Context, req *show.List
 			dto.FinishTime = lastRecord.CreateTime.Unix()
 		} else {
 			// 无作答记录则均用-1占位
-			for _, cq := range v.Question.ChoiceQuestions {
+			for _, cq := range v.Question.VisibleChoiceQuestions() {
 				records = append(records, &show.ListSimpleExercisesResp_Record{
 					Id:    cq.Id,
 					Score: -1,
@@ -156,9 +129,16 @@ func (s ExerciseService) GetExercise(ctx context.Context, req *show.GetExerciseR
 	if err != nil {
 		return nil, err
 	}
-	// 处理选择题切片
+	if e.Status == consts.DeleteStatus {
+		return nil, consts.ErrNotFound
+	}
+	// 只有练习的归属用户和绑定的家长可以查看
+	if _, err = s.RelationService.Target(ctx, e.UserId); err != nil {
+		return nil, err
+	}
+	// 处理选择题切片, 被屏蔽的题目不返回
 	cqs := make([]*show.ChoiceQuestion, 0)
-	for _, v := range e.Question.ChoiceQuestions {
+	for _, v := range e.Question.VisibleChoiceQuestions() {
 		// 处理各个选项
 		ops := make([]*show.Option, 0)
 		for _, o := range v.Options {
@@ -219,19 +199,25 @@ func (s ExerciseService) GetExercise(ctx context.Context, req *show.GetExerciseR
 
 // DoExercise 提交一次练习作答，目前是没有暂时记录的，需要完成所有的题目然后结算
 func (s ExerciseService) DoExercise(ctx context.Context, req *show.DoExerciseReq) (resp *show.DoExerciseResp, err error) {
+	userMeta := adaptor.ExtractUserMeta(ctx)
+	if userMeta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
 	e, err := s.ExerciseMapper.FindOneById(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
-
-	// 初始化
-	if e.History == nil {
-		rds := make([]*exercise.Records, 0)
-		e.History = &exercise.History{Records: rds}
+	if e.Status == consts.DeleteStatus {
+		return nil, consts.ErrNotFound
+	}
+	// 只有练习的归属用户可以作答, 避免替他人作答刷榜单得分
+	if e.UserId != userMeta.GetUserId() {
+		return nil, consts.ErrForbidden
 	}
 
-	// 用map存储题目id与题目
-	cqs := e.Question.ChoiceQuestions
+	// 用map存储题目id与题目, 被屏蔽的题目不计分
+	cqs := e.Question.VisibleChoiceQuestions()
 	qMap := make(map[string]*exercise.ChoiceQuestion)
 	for _, v := range cqs {
 		qMap[v.Id] = v
@@ -239,15 +225,19 @@ func (s ExerciseService) DoExercise(ctx context.Context, req *show.DoExerciseReq
 
 	// 做题记录
 	rs := make([]*exercise.Record, 0)
-	var sum int64
+	var sum, correct int64
 	for _, v := range req.Records {
 		// 根据id获取题目
 		if q, ok := qMap[v.Id]; ok {
-			var score int64
+			var score, full int64
 			for _, o := range q.Options {
 				if o.Option == v.Option {
 					score = o.Score
 				}
+				full = max(full, o.Score)
+			}
+			if score > 0 && score == full {
+				correct++
 			}
 			// 构造单题记录
 			r := &exercise.Record{
@@ -266,16 +256,21 @@ func (s ExerciseService) DoExercise(ctx context.Context, req *show.DoExerciseReq
 		CreateTime: time.Now(),
 	}
 
-	// 更新记录
-	e.History.Records = append(e.History.Records, rds)
-	err = s.ExerciseMapper.Update(ctx, e)
+	// 追加作答记录, 并发的提交和屏蔽题目互不覆盖
+	n, err := s.ExerciseMapper.AddRecords(ctx, e.ID, rds)
 	if err != nil {
 		return nil, err
 	}
 
-	// 将最新的记录返回
+	// 首次提交计入排行榜
+	if n == 1 {
+		s.RankService.OnExercise(ctx, e.UserId, sum)
+		s.Bus.Publish(ctx, &event.Event{Type: event.ExerciseSubmitted, UserId: e.UserId, Value: correct})
+	}
+
+	// 将本次的记录返回
 	rsDto := make([]*show.Record, 0)
-	for _, v := range e.History.Records[len(e.History.Records)-1].Records {
+	for _, v := range rds.Records {
 		rsDto = append(rsDto, &show.Record{
 			Id:     v.Id,
 			Option: v.Option,
@@ -318,3 +313,263 @@ func (s ExerciseService) LikeExercise(ctx context.Context, req *show.LikeExercis
 
 	return util.Succeed("标记成功")
 }
+
+// DeleteExercise 删除一套练习, 仅做软删除
+func (s ExerciseService) DeleteExercise(ctx context.Context, req *show.DeleteExerciseReq) (resp *show.Response, err error) {
+	// 获取用户信息
+	userMeta := adaptor.ExtractUserMeta(ctx)
+	if userMeta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	// 查询练习
+	e, err := s.ExerciseMapper.FindOneById(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status == consts.DeleteStatus {
+		return nil, consts.ErrNotFound
+	}
+	if e.UserId != userMeta.GetUserId() {
+		return nil, consts.ErrForbidden
+	}
+
+	// 标记删除
+	if err = s.ExerciseMapper.Delete(ctx, e); err != nil {
+		return util.Fail(999, "删除失败"), nil
+	}
+	return util.Succeed("删除成功")
+}
+
+// RegenerateExercise 为原练习对应的批改记录重新生成一套练习
+func (s ExerciseService) RegenerateExercise(ctx context.Context, req *show.RegenerateExerciseReq) (resp *show.CreateExerciseResp, err error) {
+	// 获取用户信息
+	userMeta := adaptor.ExtractUserMeta(ctx)
+	if userMeta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	// 查询原练习
+	e, err := s.ExerciseMapper.FindOneById(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if e.UserId != userMeta.GetUserId() {
+		return nil, consts.ErrForbidden
+	}
+	return s.create(ctx, userMeta.GetUserId(), e.LogId)
+}
+
+// ReportQuestion 举报练习中的一道题目, 举报后该练习中立即屏蔽该题, 举报记录供管理员复核
+// 练习只属于一个用户, 只有归属用户和绑定的家长可以举报
+func (s ExerciseService) ReportQuestion(ctx context.Context, req *show.ReportQuestionReq) (resp *show.Response, err error) {
+	// 获取用户信息
+	userMeta := adaptor.ExtractUserMeta(ctx)
+	if userMeta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	// 校验参数
+	if req.Reason < consts.ReportWrongAnswer || req.Reason > consts.ReportOther {
+		return nil, consts.ErrInvalidParams
+	}
+
+	// 查询练习与题目
+	e, err := s.ExerciseMapper.FindOneById(ctx, req.ExerciseId)
+	if err != nil {
+		return nil, err
+	}
+	if e.Status == consts.DeleteStatus {
+		return nil, consts.ErrNotFound
+	}
+	if _, err = s.RelationService.Target(ctx, e.UserId); err != nil {
+		return nil, err
+	}
+	var q *exercise.ChoiceQuestion
+	for _, cq := range e.Question.ChoiceQuestions {
+		if cq.Id == req.QuestionId {
+			q = cq
+		}
+	}
+	if q == nil {
+		return nil, consts.ErrNotFound
+	}
+
+	// 同一用户对同一题目只能举报一次
+	r, err := s.ReportMapper.FindOneByUser(ctx, req.ExerciseId, req.QuestionId, userMeta.GetUserId())
+	if err == nil && r != nil {
+		return nil, consts.ErrRepeatReport
+	} else if !errors.Is(err, consts.ErrNotFound) {
+		return nil, err
+	}
+
+	// 插入举报记录, 并发的重复举报由唯一索引拦截
+	err = s.ReportMapper.Insert(ctx, &exercise.Report{
+		ExerciseId: req.ExerciseId,
+		QuestionId: req.QuestionId,
+		UserId:     userMeta.GetUserId(),
+		Reason:     req.Reason,
+		Comment:    req.GetComment(),
+	})
+	if errors.Is(err, consts.ErrRepeatReport) {
+		return nil, err
+	} else if err != nil {
+		return nil, consts.ErrReport
+	}
+
+	// 屏蔽该题, 只修改该题的屏蔽状态, 不会覆盖并发提交的作答记录
+	if !q.Hidden {
+		if err = s.ExerciseMapper.HideQuestion(ctx, e.ID, q.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return util.Succeed("举报成功")
+}
+
+// ListQuestionReports 按题目汇总举报记录, 仅管理员可用于人工复核
+func (s ExerciseService) ListQuestionReports(ctx context.Context, req *show.ListQuestionReportsReq) (resp *show.ListQuestionReportsResp, err error) {
+	// 仅管理员可用
+	if _, err = checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// 分页查询举报汇总
+	data, total, err := s.ReportMapper.Summarize(ctx, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// 构造dto切片
+	dtos := make([]*show.QuestionReport, 0, len(data))
+	for _, v := range data {
+		dto := &show.QuestionReport{
+			ExerciseId: v.ExerciseId,
+			QuestionId: v.QuestionId,
+			Count:      v.Count,
+			Reasons:    make([]*show.QuestionReport_ReasonCount, 0),
+			Comments:   make([]string, 0),
+		}
+		// 统计各举报原因的次数
+		reasons := make(map[int64]int64)
+		for _, r := range v.Reasons {
+			reasons[r]++
+		}
+		for r := int64(consts.ReportWrongAnswer); r <= consts.ReportOther; r++ {
+			if reasons[r] > 0 {
+				dto.Reasons = append(dto.Reasons, &show.QuestionReport_ReasonCount{Reason: r, Count: reasons[r]})
+			}
+		}
+		for _, c := range v.Comments {
+			if c != "" {
+				dto.Comments = append(dto.Comments, c)
+			}
+		}
+		// 补充题目内容与屏蔽状态
+		if e, err := s.ExerciseMapper.FindOneById(ctx, v.ExerciseId); err == nil {
+			for _, cq := range e.Question.ChoiceQuestions {
+				if cq.Id == v.QuestionId {
+					dto.Question = cq.Question
+					dto.Hidden = cq.Hidden
+				}
+			}
+		}
+		dtos = append(dtos, dto)
+	}
+
+	// 构造响应
+	resp = &show.ListQuestionReportsResp{
+		Code:    0,
+		Msg:     "success",
+		Reports: dtos,
+		Total:   total,
+	}
+	return
+}
+
+// create 为一条批改记录生成一套练习
+// 每条批改记录首次生成及之后的若干次重新生成免费, 超出后每次生成扣除一次剩余次数
+func (s ExerciseService) create(ctx context.Context, userId, logId string) (resp *show.CreateExerciseResp, err error) {
+	// 获取批改记录
+	l, err := s.LogMapper.FindOne(ctx, logId)
+	if err != nil {
+		return nil, err
+	}
+	if l.UserId != userId {
+		return nil, consts.ErrForbidden
+	}
+
+	// 获取用户信息
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 原子地预占一次生成, 并发的重新生成不会同时用到同一次免费机会
+	n, err := s.ExerciseMapper.CountByLogId(ctx, userId, logId)
+	if err != nil {
+		return nil, err
+	}
+	k, err := s.LogMapper.ReserveExercise(ctx, logId, n)
+	if err != nil {
+		return nil, err
+	}
+	charge := k-1 > config.GetConfig().Exercise.FreeRegenerations
+
+	// 生成或存储失败时退回预占和扣除的次数
+	rollback := func(charged bool) {
+		if err := s.LogMapper.ReleaseExercise(ctx, logId); err != nil {
+			logx.CtxError(ctx, "exercise: release %s error %v", logId, err)
+		}
+		if charged {
+			if _, err := s.QuotaService.Change(ctx, userId, 1, ledger.ReasonRegenerateFailed, logId); err != nil {
+				logx.CtxError(ctx, "exercise: refund %s to %s error %v", logId, userId, err)
+			}
+		}
+	}
+
+	// 超出免费次数时先扣除剩余次数
+	if charge {
+		if u.Count <= 0 {
+			rollback(false)
+			return nil, consts.ErrInSufficientCount
+		}
+		if _, err = s.QuotaService.Change(ctx, userId, -1, ledger.ReasonRegenerateExercise, logId); err != nil {
+			rollback(false)
+			return nil, err
+		}
+	}
+
+	// 调用生成接口
+	e, err := eu.GenerateExercise(ctx, u.Grade, l)
+	if err != nil {
+		rollback(charge)
+		return nil, err
+	}
+
+	// 存储练习
+	e.LogId = logId
+	e.UserId = userId
+	err = s.ExerciseMapper.Insert(ctx, e)
+	if err != nil {
+		rollback(charge)
+		return nil, err
+	}
+
+	// dto构造
+	dto := &show.Exercise{}
+	err = copier.Copy(dto, e)
+	if err != nil {
+		return nil, err
+	}
+	dto.Id = e.ID.Hex()
+	dto.CreateTime = e.CreateTime.Unix()
+	dto.UpdateTime = e.CreateTime.Unix()
+
+	resp = &show.CreateExerciseResp{
+		Code:     0,
+		Msg:      "success",
+		Exercise: dto,
+	}
+	return
+}
@@ -1,23 +1,39 @@
 package service
 
 import (
+	"errors"
 	"github.com/google/wire"
 	"github.com/xh-polaris/essay-show/biz/adaptor"
 	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/exercise"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/feedback"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
 	"golang.org/x/net/context"
+	"time"
 )
 
 type IFeedbackService interface {
 	Submit(ctx context.Context, req *show.SubmitFeedbackReq) (*show.Response, error)
+	ListMyFeedbacks(ctx context.Context, req *show.ListMyFeedbacksReq) (*show.ListFeedbacksResp, error)
+	ListFeedbacks(ctx context.Context, req *show.ListFeedbacksReq) (*show.ListFeedbacksResp, error)
+	AssignFeedback(ctx context.Context, req *show.AssignFeedbackReq) (*show.Response, error)
+	UpdateFeedbackStatus(ctx context.Context, req *show.UpdateFeedbackStatusReq) (*show.Response, error)
+	ReplyFeedback(ctx context.Context, req *show.ReplyFeedbackReq) (*show.Response, error)
+	GetFeedback(ctx context.Context, req *show.GetFeedbackReq) (*show.GetFeedbackResp, error)
+	FeedbackStats(ctx context.Context, req *show.FeedbackStatsReq) (*show.FeedbackStatsResp, error)
 }
 
 type FeedBackService struct {
-	FeedbackMapper *feedback.MongoMapper
-	UserMapper     *user.MongoMapper
+	FeedbackMapper      *feedback.MongoMapper
+	UserMapper          *user.MongoMapper
+	LogMapper           *log.MongoMapper
+	ExerciseMapper      *exercise.MongoMapper
+	NotificationService INotificationService
 }
 
 var FeedbackServiceSet = wire.NewSet(
@@ -39,9 +55,323 @@ func (s *FeedBackService) Submit(ctx context.Context, req *show.SubmitFeedbackRe
 		Images:  req.Images,
 	}
 
+	// 反馈针对具体的批改记录或题目时, 校验调用者是否拥有该资源
+	if req.LogId != nil || req.ExerciseId != nil {
+		ref, err := s.checkRef(ctx, meta.GetUserId(), req)
+		if err != nil {
+			return nil, err
+		}
+		f.Ref = ref
+	}
+
 	err := s.FeedbackMapper.Insert(ctx, f)
 	if err != nil {
 		return util.Fail(999, "反馈失败"), nil
 	}
 	return util.Succeed("反馈成功")
 }
+
+// ListMyFeedbacks 分页获取自己提交的反馈及收到的回复
+func (s *FeedBackService) ListMyFeedbacks(ctx context.Context, req *show.ListMyFeedbacksReq) (*show.ListFeedbacksResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	fs, total, err := s.FeedbackMapper.FindMany(ctx, &feedback.Filter{UserId: meta.GetUserId()}, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.Feedback, 0, len(fs))
+	for _, f := range fs {
+		dto := feedbackDTO(f)
+		// 处理记录和负责人仅管理员可见
+		dto.Owner, dto.Notes = "", nil
+		for _, r := range dto.Replies {
+			r.AdminId = ""
+		}
+		dtos = append(dtos, dto)
+	}
+	return &show.ListFeedbacksResp{
+		Code:      0,
+		Msg:       "success",
+		Feedbacks: dtos,
+		Total:     total,
+	}, nil
+}
+
+// ListFeedbacks 管理员按类型、状态和提交时间筛选反馈
+func (s *FeedBackService) ListFeedbacks(ctx context.Context, req *show.ListFeedbacksReq) (*show.ListFeedbacksResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	filter := &feedback.Filter{Type: req.Type, Status: req.Status}
+	if req.StartTime != nil {
+		filter.Start = time.Unix(*req.StartTime, 0)
+	}
+	if req.EndTime != nil {
+		filter.End = time.Unix(*req.EndTime, 0)
+	}
+	fs, total, err := s.FeedbackMapper.FindMany(ctx, filter, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.Feedback, 0, len(fs))
+	for _, f := range fs {
+		dtos = append(dtos, feedbackDTO(f))
+	}
+	return &show.ListFeedbacksResp{
+		Code:      0,
+		Msg:       "success",
+		Feedbacks: dtos,
+		Total:     total,
+	}, nil
+}
+
+// AssignFeedback 指定负责处理反馈的管理员, 不指定时由调用者负责
+func (s *FeedBackService) AssignFeedback(ctx context.Context, req *show.AssignFeedbackReq) (*show.Response, error) {
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := req.Owner
+	if owner == "" {
+		owner = adminId
+	}
+	if err = s.FeedbackMapper.Assign(ctx, req.Id, owner); err != nil {
+		return nil, err
+	}
+	return util.Succeed("success")
+}
+
+// UpdateFeedbackStatus 变更反馈的处理状态并记录备注
+func (s *FeedBackService) UpdateFeedbackStatus(ctx context.Context, req *show.UpdateFeedbackStatusReq) (*show.Response, error) {
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status < feedback.StatusPending || req.Status > feedback.StatusDone {
+		return nil, consts.ErrInvalidParams
+	}
+
+	if err = s.FeedbackMapper.UpdateStatus(ctx, req.Id, &feedback.Note{
+		AdminId: adminId,
+		Status:  int(req.Status),
+		Content: req.Note,
+	}); err != nil {
+		return nil, err
+	}
+	return util.Succeed("success")
+}
+
+// ReplyFeedback 回复用户的反馈, 用户可在反馈记录中查看
+func (s *FeedBackService) ReplyFeedback(ctx context.Context, req *show.ReplyFeedbackReq) (*show.Response, error) {
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Content == "" {
+		return nil, consts.ErrInvalidParams
+	}
+
+	f, err := s.FeedbackMapper.FindOne(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.FeedbackMapper.AddReply(ctx, req.Id, &feedback.Reply{
+		AdminId: adminId,
+		Content: req.Content,
+	}); err != nil {
+		return nil, err
+	}
+	if err = s.NotificationService.Notify(ctx, &notification.Notification{
+		UserId:  f.UserId,
+		Type:    notification.TypeFeedbackReplied,
+		Title:   "你的反馈收到了回复",
+		Content: req.Content,
+		RefId:   req.Id,
+	}); err != nil {
+		logx.CtxError(ctx, "feedback: notify reply of %s error %v", req.Id, err)
+	}
+	return util.Succeed("success")
+}
+
+// checkRef 校验反馈针对的批改记录或题目属于调用者, 针对题目时同时记录题目所属的批改记录
+func (s *FeedBackService) checkRef(ctx context.Context, userId string, req *show.SubmitFeedbackReq) (*feedback.Ref, error) {
+	ref := &feedback.Ref{LogId: req.GetLogId()}
+	if req.ExerciseId != nil {
+		e, err := s.ExerciseMapper.FindOneById(ctx, req.GetExerciseId())
+		if err != nil {
+			return nil, err
+		}
+		if e.Status == consts.DeleteStatus {
+			return nil, consts.ErrNotFound
+		}
+		if e.UserId != userId {
+			return nil, consts.ErrForbidden
+		}
+		if ref.LogId != "" && ref.LogId != e.LogId {
+			return nil, consts.ErrInvalidParams
+		}
+		if findQuestion(e, req.GetQuestionId()) == nil {
+			return nil, consts.ErrNotFound
+		}
+		ref.LogId, ref.ExerciseId, ref.QuestionId = e.LogId, e.ID.Hex(), req.GetQuestionId()
+		return ref, nil
+	}
+
+	l, err := s.LogMapper.FindOne(ctx, ref.LogId)
+	if err != nil {
+		return nil, err
+	}
+	if l.UserId != userId {
+		return nil, consts.ErrForbidden
+	}
+	return ref, nil
+}
+
+// GetFeedback 管理员查看反馈详情, 同时返回反馈针对的原作文或题目
+func (s *FeedBackService) GetFeedback(ctx context.Context, req *show.GetFeedbackReq) (*show.GetFeedbackResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	f, err := s.FeedbackMapper.FindOne(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &show.GetFeedbackResp{
+		Code:     0,
+		Msg:      "success",
+		Feedback: feedbackDTO(f),
+	}
+	if f.Ref == nil {
+		return resp, nil
+	}
+
+	if resp.RefCount, err = s.FeedbackMapper.CountByRef(ctx, f.Ref); err != nil {
+		return nil, err
+	}
+	if f.Ref.LogId != "" {
+		l, err := s.LogMapper.FindOne(ctx, f.Ref.LogId)
+		if err != nil && !errors.Is(err, consts.ErrNotFound) {
+			return nil, err
+		}
+		if l != nil {
+			resp.Essay = &show.Log{
+				Id:         l.ID.Hex(),
+				Grade:      l.Grade,
+				Ocr:        l.Ocr,
+				Response:   l.Response,
+				Like:       l.Like,
+				CreateTime: l.CreateTime.Unix(),
+			}
+		}
+	}
+	if f.Ref.ExerciseId != "" {
+		e, err := s.ExerciseMapper.FindOneById(ctx, f.Ref.ExerciseId)
+		if err != nil && !errors.Is(err, consts.ErrNotFound) {
+			return nil, err
+		}
+		if e != nil {
+			if q := findQuestion(e, f.Ref.QuestionId); q != nil {
+				resp.Question = choiceQuestionDTO(q)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// FeedbackStats 按批改记录或题目汇总反馈数量, 反馈最多的资源排在最前, 用于发现批改和出题的质量问题
+func (s *FeedBackService) FeedbackStats(ctx context.Context, req *show.FeedbackStatsReq) (*show.FeedbackStatsResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = consts.PageSize
+	}
+	stats, err := s.FeedbackMapper.Stats(ctx, req.Type, limit)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.FeedbackStat, 0, len(stats))
+	for _, st := range stats {
+		dtos = append(dtos, &show.FeedbackStat{
+			LogId:      st.Ref.LogId,
+			ExerciseId: st.Ref.ExerciseId,
+			QuestionId: st.Ref.QuestionId,
+			Count:      st.Count,
+		})
+	}
+	return &show.FeedbackStatsResp{
+		Code:  0,
+		Msg:   "success",
+		Stats: dtos,
+	}, nil
+}
+
+func findQuestion(e *exercise.Exercise, id string) *exercise.ChoiceQuestion {
+	if e.Question == nil {
+		return nil
+	}
+	for _, q := range e.Question.ChoiceQuestions {
+		if q.Id == id {
+			return q
+		}
+	}
+	return nil
+}
+
+func choiceQuestionDTO(q *exercise.ChoiceQuestion) *show.ChoiceQuestion {
+	ops := make([]*show.Option, 0, len(q.Options))
+	for _, o := range q.Options {
+		ops = append(ops, &show.Option{
+			Option:  o.Option,
+			Content: o.Content,
+			Score:   o.Score,
+		})
+	}
+	return &show.ChoiceQuestion{
+		Id:          q.Id,
+		Question:    q.Question,
+		Explanation: q.Explanation,
+		Options:     ops,
+	}
+}
+
+func feedbackDTO(f *feedback.Feedback) *show.Feedback {
+	dto := &show.Feedback{
+		Id:         f.ID.Hex(),
+		UserId:     f.UserId,
+		Type:       f.Type,
+		Content:    f.Content,
+		Images:     f.Images,
+		Status:     int64(f.Status),
+		Owner:      f.Owner,
+		CreateTime: f.CreateTime.Unix(),
+		UpdateTime: f.UpdateTime.Unix(),
+	}
+	if f.Ref != nil {
+		dto.LogId, dto.ExerciseId, dto.QuestionId = f.Ref.LogId, f.Ref.ExerciseId, f.Ref.QuestionId
+	}
+	for _, n := range f.Notes {
+		dto.Notes = append(dto.Notes, &show.FeedbackNote{
+			AdminId:    n.AdminId,
+			Status:     int64(n.Status),
+			Content:    n.Content,
+			CreateTime: n.CreateTime.Unix(),
+		})
+	}
+	for _, r := range f.Replies {
+		dto.Replies = append(dto.Replies, &show.FeedbackReply{
+			AdminId:    r.AdminId,
+			Content:    r.Content,
+			CreateTime: r.CreateTime.Unix(),
+		})
+	}
+	return dto
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/moderation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	moderationx "github.com/xh-polaris/essay-show/biz/infrastructure/moderation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+)
+
+type IModerationService interface {
+	Screen(ctx context.Context, userId, title, text string) error
+	ListModerations(ctx context.Context, req *show.ListModerationsReq) (*show.ListModerationsResp, error)
+	ResolveModeration(ctx context.Context, req *show.ResolveModerationReq) (*show.Response, error)
+}
+
+// ModerationService 在批改前审核作文, 拒绝不适宜或不像作文的提交, 发现自伤或霸凌迹象时提醒管理员
+type ModerationService struct {
+	ModerationMapper    *moderation.MongoMapper
+	Moderator           *moderationx.Moderator
+	NotificationService INotificationService
+}
+
+var ModerationServiceSet = wire.NewSet(
+	wire.Struct(new(ModerationService), "*"),
+	wire.Bind(new(IModerationService), new(*ModerationService)),
+)
+
+// Screen 审核提交的作文, 未通过时保存原文和原因, 拒绝批改时返回错误
+func (s *ModerationService) Screen(ctx context.Context, userId, title, text string) error {
+	res := s.Moderator.Check(title + "\n" + text)
+	if res.Verdict == moderationx.VerdictPass {
+		return nil
+	}
+	r := &moderation.Record{
+		UserId:  userId,
+		Verdict: res.Verdict,
+		Reasons: res.Reasons,
+		Hits:    res.Hits,
+		Title:   title,
+		Text:    text,
+		Status:  moderation.StatusPending,
+	}
+	if err := s.ModerationMapper.Insert(ctx, r); err != nil {
+		// 记录失败不影响审核结论
+		logx.CtxError(ctx, "moderation: save record of %s error %v", userId, err)
+	}
+	if res.Alerted() {
+		s.alert(ctx, r)
+	}
+	if !res.Blocked() {
+		return nil
+	}
+	for _, reason := range res.Reasons {
+		if reason == moderationx.ReasonKeyword {
+			return consts.ErrContentRejected
+		}
+	}
+	return consts.ErrNotEssay
+}
+
+// ListModerations 管理员分页查看审核记录, 可以按结论和复核状态筛选
+func (s *ModerationService) ListModerations(ctx context.Context, req *show.ListModerationsReq) (*show.ListModerationsResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	rs, total, err := s.ModerationMapper.FindMany(ctx, &moderation.Filter{
+		Verdict: req.GetVerdict(),
+		Status:  req.Status,
+	}, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.ModerationRecord, 0, len(rs))
+	for _, r := range rs {
+		dtos = append(dtos, &show.ModerationRecord{
+			Id:         r.ID.Hex(),
+			UserId:     r.UserId,
+			Verdict:    r.Verdict,
+			Reasons:    r.Reasons,
+			Hits:       r.Hits,
+			Title:      r.Title,
+			Text:       r.Text,
+			Status:     r.Status,
+			ReviewerId: r.ReviewerId,
+			Note:       r.Note,
+			CreateTime: r.CreateTime.Unix(),
+			UpdateTime: r.UpdateTime.Unix(),
+		})
+	}
+	return &show.ListModerationsResp{
+		Code:    0,
+		Msg:     "success",
+		Records: dtos,
+		Total:   total,
+	}, nil
+}
+
+// ResolveModeration 管理员复核审核记录并填写备注
+func (s *ModerationService) ResolveModeration(ctx context.Context, req *show.ResolveModerationReq) (*show.Response, error) {
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.ModerationMapper.Resolve(ctx, req.Id, adminId, req.Note); err != nil {
+		return nil, err
+	}
+	return util.Succeed("复核成功")
+}
+
+// alert 通知所有管理员复核, 失败只记录日志
+func (s *ModerationService) alert(ctx context.Context, r *moderation.Record) {
+	for _, id := range config.GetConfig().Admins {
+		err := s.NotificationService.Notify(ctx, &notification.Notification{
+			UserId:  id,
+			Type:    notification.TypeModeration,
+			Title:   "有作文需要人工关注",
+			Content: "一篇提交的作文中出现了自伤或霸凌相关的表述，请尽快复核",
+			RefId:   r.ID.Hex(),
+		})
+		if err != nil {
+			logx.CtxError(ctx, "moderation: notify admin %s error %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/push"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"strconv"
+)
+
+type INotificationService interface {
+	ListNotifications(ctx context.Context, req *show.ListNotificationsReq) (*show.ListNotificationsResp, error)
+	ReadNotifications(ctx context.Context, req *show.ReadNotificationsReq) (*show.Response, error)
+	ReadAllNotifications(ctx context.Context, req *show.ReadAllNotificationsReq) (*show.Response, error)
+	Notify(ctx context.Context, n *notification.Notification) error
+	Subscribe()
+	RemindStreak(ctx context.Context) error
+}
+
+// NotificationService 管理用户的站内通知收件箱, 其他服务通过Notify发送通知
+type NotificationService struct {
+	NotificationMapper *notification.MongoMapper
+	AttendMapper       *attend.MongoMapper
+	Pusher             push.Pusher
+	Bus                *event.Bus
+	Clock              clock.Clock
+}
+
+var NotificationServiceSet = wire.NewSet(
+	wire.Struct(new(NotificationService), "*"),
+	wire.Bind(new(INotificationService), new(*NotificationService)),
+)
+
+// ListNotifications 分页获取自己的通知及未读数量
+func (s *NotificationService) ListNotifications(ctx context.Context, req *show.ListNotificationsReq) (*show.ListNotificationsResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	ns, total, err := s.NotificationMapper.FindMany(ctx, meta.GetUserId(), req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.NotificationMapper.CountUnread(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.Notification, 0, len(ns))
+	for _, n := range ns {
+		dtos = append(dtos, &show.Notification{
+			Id:         n.ID.Hex(),
+			Type:       n.Type,
+			Title:      n.Title,
+			Content:    n.Content,
+			RefId:      n.RefId,
+			Read:       n.Read,
+			CreateTime: n.CreateTime.Unix(),
+		})
+	}
+	return &show.ListNotificationsResp{
+		Code:          0,
+		Msg:           "success",
+		Notifications: dtos,
+		Total:         total,
+		Unread:        unread,
+	}, nil
+}
+
+// ReadNotifications 将指定通知标记为已读
+func (s *NotificationService) ReadNotifications(ctx context.Context, req *show.ReadNotificationsReq) (*show.Response, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	if err := s.NotificationMapper.MarkRead(ctx, meta.GetUserId(), req.Ids); err != nil {
+		return nil, err
+	}
+	return util.Succeed("success")
+}
+
+// ReadAllNotifications 将所有通知标记为已读
+func (s *NotificationService) ReadAllNotifications(ctx context.Context, req *show.ReadAllNotificationsReq) (*show.Response, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	if err := s.NotificationMapper.MarkAllRead(ctx, meta.GetUserId()); err != nil {
+		return nil, err
+	}
+	return util.Succeed("success")
+}
+
+// Notify 向用户的收件箱发送一条通知并尝试站外推送, 推送失败只记录日志
+func (s *NotificationService) Notify(ctx context.Context, n *notification.Notification) error {
+	if err := s.NotificationMapper.Insert(ctx, n); err != nil {
+		return err
+	}
+	if err := s.Pusher.Push(ctx, &push.Message{
+		UserId:  n.UserId,
+		Type:    n.Type,
+		Title:   n.Title,
+		Content: n.Content,
+	}); err != nil {
+		logx.CtxError(ctx, "notification: push %s to %s error %v", n.Type, n.UserId, err)
+	}
+	return nil
+}
+
+// Subscribe 订阅需要通知用户的领域事件
+func (s *NotificationService) Subscribe() {
+	s.Bus.Subscribe(s.handle, event.AchievementEarned, event.InvitationAccepted)
+}
+
+func (s *NotificationService) handle(ctx context.Context, e *event.Event) error {
+	n := &notification.Notification{UserId: e.UserId}
+	switch e.Type {
+	case event.AchievementEarned:
+		n.Type, n.Title, n.Content = notification.TypeAchievement, "获得新徽章", "恭喜你获得了一枚新徽章，快去看看吧"
+	case event.InvitationAccepted:
+		n.Type, n.Title, n.Content = notification.TypeInvitation, "邀请奖励已到账", "你邀请的好友完成了首次批改，邀请奖励已发放"
+	default:
+		return nil
+	}
+	return s.Notify(ctx, n)
+}
+
+// RemindStreak 在每天的提醒时刻, 提醒昨天连续签到而今天还未签到的用户
+func (s *NotificationService) RemindStreak(ctx context.Context) error {
+	c := config.GetConfig().Notice
+	now := s.Clock.Now()
+	if int64(now.Hour()) != c.StreakRemind {
+		return nil
+	}
+
+	loc := now.Location()
+	today := attend.DateKey(now, loc)
+	as, err := s.AttendMapper.FindManyByDate(ctx, attend.DateKey(now.AddDate(0, 0, -1), loc), c.StreakMin)
+	if err != nil {
+		return err
+	}
+	attended, err := s.AttendMapper.FindManyByDate(ctx, today, 0)
+	if err != nil {
+		return err
+	}
+	done := make(map[string]bool, len(attended))
+	for _, a := range attended {
+		done[a.UserId] = true
+	}
+	for _, a := range as {
+		if done[a.UserId] {
+			continue
+		}
+		if err = s.Notify(ctx, &notification.Notification{
+			UserId:  a.UserId,
+			Type:    notification.TypeStreakReminder,
+			Title:   "连续签到即将中断",
+			Content: "你已连续签到" + strconv.FormatInt(a.Streak, 10) + "天，今天还没有签到哦",
+			RefId:   today,
+		}); err != nil {
+			logx.CtxError(ctx, "notification: remind streak to %s error %v", a.UserId, err)
+		}
+	}
+	return nil
+}
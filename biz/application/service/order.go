@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/order"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/payment"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"time"
+)
+
+// stuckAfter 支付后超过该时间仍未到账的订单由定时任务补发权益
+const stuckAfter = 5 * time.Minute
+
+type IOrderService interface {
+	ListProducts(ctx context.Context, req *show.ListProductsReq) (*show.ListProductsResp, error)
+	CreateOrder(ctx context.Context, req *show.CreateOrderReq) (*show.CreateOrderResp, error)
+	GetOrder(ctx context.Context, req *show.GetOrderReq) (*show.GetOrderResp, error)
+	ListOrders(ctx context.Context, req *show.ListOrdersReq) (*show.ListOrdersResp, error)
+	PaymentNotify(ctx context.Context, headers map[string]string, body []byte) (*payment.NotifyAck, error)
+	RefundOrder(ctx context.Context, req *show.RefundOrderReq) (*show.Response, error)
+	Sweep(ctx context.Context) error
+}
+
+// OrderService 处理商品购买, 支付成功后发放批改次数或开通套餐
+type OrderService struct {
+	OrderMapper  *order.MongoMapper
+	UserMapper   *user.MongoMapper
+	Gateway      payment.Gateway
+	QuotaService IQuotaService
+	PlanService  IPlanService
+	Clock        clock.Clock
+}
+
+var OrderServiceSet = wire.NewSet(
+	wire.Struct(new(OrderService), "*"),
+	wire.Bind(new(IOrderService), new(*OrderService)),
+)
+
+// ListProducts 获取可购买的商品
+func (s *OrderService) ListProducts(ctx context.Context, req *show.ListProductsReq) (*show.ListProductsResp, error) {
+	ps := config.GetConfig().Products
+	dtos := make([]*show.Product, 0, len(ps))
+	for _, p := range ps {
+		dtos = append(dtos, &show.Product{
+			Id:      p.Id,
+			Name:    p.Name,
+			Price:   p.Price,
+			Credits: p.Credits,
+			PlanId:  p.PlanId,
+		})
+	}
+	return &show.ListProductsResp{
+		Code:     0,
+		Msg:      "success",
+		Products: dtos,
+	}, nil
+}
+
+// CreateOrder 创建订单并向网关下单, 返回客户端拉起支付所需的参数
+func (s *OrderService) CreateOrder(ctx context.Context, req *show.CreateOrderReq) (*show.CreateOrderResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	o, params, err := s.create(ctx, meta.GetUserId(), req.ProductId)
+	if err != nil {
+		return nil, err
+	}
+	return &show.CreateOrderResp{
+		Code:      0,
+		Msg:       "success",
+		Order:     orderDTO(o),
+		PayParams: params,
+	}, nil
+}
+
+// create 为用户创建订单并向网关下单
+func (s *OrderService) create(ctx context.Context, userId, productId string) (*order.Order, map[string]string, error) {
+	// 查找商品, 价格以服务端配置为准
+	var product *config.Product
+	for i, p := range config.GetConfig().Products {
+		if p.Id == productId {
+			product = &config.GetConfig().Products[i]
+			break
+		}
+	}
+	if product == nil {
+		return nil, nil, consts.ErrProductNotFound
+	}
+
+	// 创建订单
+	o := &order.Order{
+		UserId:    userId,
+		ProductId: product.Id,
+		Name:      product.Name,
+		Amount:    product.Price,
+		Credits:   product.Credits,
+		PlanId:    product.PlanId,
+		Status:    order.StatusPending,
+	}
+	if err := s.OrderMapper.Insert(ctx, o); err != nil {
+		return nil, nil, err
+	}
+
+	// 向网关下单
+	params, err := s.Gateway.Prepay(ctx, &payment.Prepay{
+		OrderId:     o.ID.Hex(),
+		Description: o.Name,
+		Amount:      o.Amount,
+	})
+	if err != nil {
+		logx.CtxError(ctx, "order: prepay order %s error %v", o.ID.Hex(), err)
+		return nil, nil, consts.ErrPayment
+	}
+	return o, params, nil
+}
+
+// GetOrder 获取自己的订单详情, 客户端支付后轮询以确认到账
+func (s *OrderService) GetOrder(ctx context.Context, req *show.GetOrderReq) (*show.GetOrderResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	o, err := s.OrderMapper.FindOne(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if o.UserId != meta.GetUserId() {
+		return nil, consts.ErrForbidden
+	}
+	return &show.GetOrderResp{
+		Code:  0,
+		Msg:   "success",
+		Order: orderDTO(o),
+	}, nil
+}
+
+// ListOrders 分页获取自己的订单
+func (s *OrderService) ListOrders(ctx context.Context, req *show.ListOrdersReq) (*show.ListOrdersResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	os, total, err := s.OrderMapper.FindMany(ctx, meta.GetUserId(), req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.Order, 0, len(os))
+	for _, o := range os {
+		dtos = append(dtos, orderDTO(o))
+	}
+	return &show.ListOrdersResp{
+		Code:   0,
+		Msg:    "success",
+		Orders: dtos,
+		Total:  total,
+	}, nil
+}
+
+// PaymentNotify 处理网关的支付结果回调
+// 网关可能重复回调, 订单状态的原子流转保证只有一次回调标记为已支付, 权益发放按订单id幂等
+// 已支付但未到账的订单在重复回调或定时任务中重试发放
+func (s *OrderService) PaymentNotify(ctx context.Context, headers map[string]string, body []byte) (*payment.NotifyAck, error) {
+	// 验签
+	n, err := s.Gateway.Verify(ctx, headers, body)
+	if err != nil {
+		logx.CtxError(ctx, "order: verify payment notification error %v", err)
+		return &payment.NotifyAck{Code: "FAIL", Message: "签名错误"}, err
+	}
+	if n.TradeState != payment.TradeSuccess {
+		return &payment.NotifyAck{Code: "SUCCESS"}, nil
+	}
+
+	// 校验金额
+	o, err := s.OrderMapper.FindOne(ctx, n.OrderId)
+	if err != nil {
+		return &payment.NotifyAck{Code: "FAIL", Message: "订单不存在"}, err
+	}
+	if o.Amount != n.Amount {
+		logx.CtxError(ctx, "order: %s amount mismatch, want %d got %d", o.ID.Hex(), o.Amount, n.Amount)
+		return &payment.NotifyAck{Code: "FAIL", Message: "金额不一致"}, consts.ErrPayment
+	}
+
+	// 标记为已支付, 重复回调时订单仍未到账则重试发放, 否则直接应答成功
+	paid, err := s.OrderMapper.Pay(ctx, n.OrderId, n.TransactionId, n.PayTime)
+	switch {
+	case errors.Is(err, consts.ErrOrderStatus):
+		if o, err = s.OrderMapper.FindOne(ctx, n.OrderId); err != nil {
+			return &payment.NotifyAck{Code: "FAIL", Message: "系统繁忙"}, err
+		}
+		if o.Status != order.StatusPaid {
+			return &payment.NotifyAck{Code: "SUCCESS"}, nil
+		}
+	case err != nil:
+		return &payment.NotifyAck{Code: "FAIL", Message: "系统繁忙"}, err
+	default:
+		o = paid
+	}
+
+	// 发放权益, 失败时订单保持已支付, 由网关重试回调或定时任务补发
+	if err = s.complete(ctx, o); err != nil {
+		return &payment.NotifyAck{Code: "FAIL", Message: "系统繁忙"}, err
+	}
+	return &payment.NotifyAck{Code: "SUCCESS"}, nil
+}
+
+// RefundOrder 管理员为已到账的订单全额退款, 并收回发放的权益
+func (s *OrderService) RefundOrder(ctx context.Context, req *show.RefundOrderReq) (*show.Response, error) {
+	// 仅管理员可用
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.refund(ctx, req.Id, req.Reason); err != nil {
+		return nil, err
+	}
+	return util.Succeed("退款成功")
+}
+
+// Sweep 为支付后长时间未到账的订单补发权益, 由定时任务调用
+func (s *OrderService) Sweep(ctx context.Context) error {
+	os, err := s.OrderMapper.FindStuck(ctx, s.Clock.Now().Add(-stuckAfter))
+	if err != nil {
+		return err
+	}
+	for _, o := range os {
+		// 单个订单失败不影响其他订单, 下次执行时继续重试
+		_ = s.complete(ctx, o)
+	}
+	return nil
+}
+
+// refund 将订单标记为已退款并向网关退款, 然后收回发放的权益
+// 发放的次数已被使用时拒绝退款, 避免收回后剩余次数为负
+func (s *OrderService) refund(ctx context.Context, id, reason string) error {
+	o, err := s.OrderMapper.FindOne(ctx, id)
+	if err != nil {
+		return err
+	}
+	if o.PlanId == "" {
+		u, err := s.UserMapper.FindOne(ctx, o.UserId)
+		if err != nil {
+			return err
+		}
+		if u.Count < o.Credits {
+			return consts.ErrRefundConsumed
+		}
+	}
+
+	// 标记为已退款, 防止重复退款
+	o, err = s.OrderMapper.Refund(ctx, id, reason, s.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	// 向网关退款, 失败时恢复为已到账
+	if err = s.Gateway.Refund(ctx, o.ID.Hex(), o.TransactionId, o.Amount); err != nil {
+		logx.CtxError(ctx, "order: refund order %s error %v", o.ID.Hex(), err)
+		if err2 := s.OrderMapper.Revert(ctx, o.ID.Hex(), order.StatusRefunded, order.StatusFulfilled); err2 != nil {
+			logx.CtxError(ctx, "order: revert order %s error %v", o.ID.Hex(), err2)
+		}
+		return consts.ErrRefund
+	}
+
+	// 收回权益
+	if o.PlanId != "" {
+		err = s.PlanService.Revoke(ctx, o.UserId, o.ID.Hex())
+	} else {
+		err = s.reclaim(ctx, o)
+	}
+	if err != nil {
+		logx.CtxError(ctx, "order: revoke order %s error %v", o.ID.Hex(), err)
+		return err
+	}
+	return nil
+}
+
+// reclaim 收回订单发放的次数, 退款期间次数被并发使用时只收回剩余的部分, 剩余次数不会为负
+func (s *OrderService) reclaim(ctx context.Context, o *order.Order) error {
+	_, err := s.QuotaService.ChangeOnce(ctx, o.UserId, -o.Credits, ledger.ReasonRefund, o.ID.Hex())
+	if !errors.Is(err, consts.ErrInSufficientCount) {
+		return err
+	}
+	u, err := s.UserMapper.FindOne(ctx, o.UserId)
+	if err != nil {
+		return err
+	}
+	logx.CtxError(ctx, "order: reclaim only %d of %d credits for order %s", u.Count, o.Credits, o.ID.Hex())
+	if u.Count <= 0 {
+		return nil
+	}
+	_, err = s.QuotaService.ChangeOnce(ctx, o.UserId, -u.Count, ledger.ReasonRefund, o.ID.Hex())
+	return err
+}
+
+// complete 为已支付的订单发放权益并标记为已到账, 可以重复调用
+func (s *OrderService) complete(ctx context.Context, o *order.Order) error {
+	if err := s.fulfill(ctx, o); err != nil {
+		logx.CtxError(ctx, "order: fulfill order %s error %v", o.ID.Hex(), err)
+		return err
+	}
+	// 并发的重试可能已标记为已到账
+	if err := s.OrderMapper.Fulfill(ctx, o.ID.Hex()); err != nil && !errors.Is(err, consts.ErrOrderStatus) {
+		logx.CtxError(ctx, "order: mark order %s fulfilled error %v", o.ID.Hex(), err)
+		return err
+	}
+	return nil
+}
+
+// fulfill 按订单发放批改次数或开通套餐, 同一订单只发放一次
+func (s *OrderService) fulfill(ctx context.Context, o *order.Order) error {
+	if o.PlanId != "" {
+		return s.PlanService.Grant(ctx, o.UserId, o.PlanId, o.ID.Hex())
+	}
+	_, err := s.QuotaService.ChangeOnce(ctx, o.UserId, o.Credits, ledger.ReasonPurchase, o.ID.Hex())
+	return err
+}
+
+func orderDTO(o *order.Order) *show.Order {
+	dto := &show.Order{
+		Id:         o.ID.Hex(),
+		ProductId:  o.ProductId,
+		Name:       o.Name,
+		Amount:     o.Amount,
+		Credits:    o.Credits,
+		PlanId:     o.PlanId,
+		Status:     o.Status,
+		CreateTime: o.CreateTime.Unix(),
+	}
+	if !o.PayTime.IsZero() {
+		dto.PayTime = o.PayTime.Unix()
+	}
+	if !o.RefundTime.IsZero() {
+		dto.RefundTime = o.RefundTime.Unix()
+	}
+	return dto
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/entitlement"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/order"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/payment"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+	"testing"
+	"time"
+)
+
+// 下单到退款的完整流程需要真实的MongoDB和Redis, 未设置TEST_MONGO_URL和TEST_REDIS_HOST时跳过
+func newOrderTestService(t *testing.T) (*OrderService, *payment.FakeGateway) {
+	c := testutil.LoadDBConfig(t)
+	c.Products = []config.Product{{Id: "credits", Name: "批改次数", Price: 990, Credits: 10}}
+
+	gateway := payment.NewFakeGateway(c.Payment.Secret)
+	userMapper := user.NewMongoMapper(c)
+	quota := &QuotaService{UserMapper: userMapper, LedgerMapper: ledger.NewMongoMapper(c)}
+	return &OrderService{
+		OrderMapper:  order.NewMongoMapper(c),
+		UserMapper:   userMapper,
+		Gateway:      gateway,
+		QuotaService: quota,
+		PlanService:  &PlanService{EntitlementMapper: entitlement.NewMongoMapper(c), UserMapper: userMapper, Clock: clock.Fixed(time.Now())},
+		Clock:        clock.Fixed(time.Now()),
+	}, gateway
+}
+
+func TestOrderFlow(t *testing.T) {
+	s, gateway := newOrderTestService(t)
+	ctx := context.Background()
+	quota := s.QuotaService.(*QuotaService)
+
+	u := &user.User{Username: "order-test", Count: 3}
+	if err := quota.UserMapper.Insert(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	userId := u.ID.Hex()
+	check := func(want int64) {
+		t.Helper()
+		u, err := quota.UserMapper.FindOne(ctx, userId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sum, err := quota.LedgerMapper.Sum(ctx, userId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Count != want || sum != want {
+			t.Fatalf("count = %d, ledger = %d, want %d", u.Count, sum, want)
+		}
+	}
+	status := func(id string, want int64) {
+		t.Helper()
+		o, err := s.OrderMapper.FindOne(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o.Status != want {
+			t.Fatalf("order %s status = %d, want %d", id, o.Status, want)
+		}
+	}
+
+	// 下单
+	o, _, err := s.create(ctx, userId, "credits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	status(o.ID.Hex(), order.StatusPending)
+
+	// 伪造的回调被拒绝
+	headers, body, err := gateway.Pay(o.ID.Hex(), o.Amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ack, _ := s.PaymentNotify(ctx, map[string]string{payment.HeaderSignature: "forged"}, body); ack.Code != "FAIL" {
+		t.Fatalf("forged notify ack = %s, want FAIL", ack.Code)
+	}
+	status(o.ID.Hex(), order.StatusPending)
+
+	// 支付回调到账, 重复回调不会重复发放
+	for i := 0; i < 2; i++ {
+		if ack, err := s.PaymentNotify(ctx, headers, body); err != nil || ack.Code != "SUCCESS" {
+			t.Fatalf("notify #%d ack = %v, error = %v", i, ack, err)
+		}
+		status(o.ID.Hex(), order.StatusFulfilled)
+		check(13)
+	}
+
+	// 已支付但发放中断的订单, 重复回调时补发
+	o2, _, err := s.create(ctx, userId, "credits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers2, body2, err := gateway.Pay(o2.ID.Hex(), o2.Amount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.OrderMapper.Pay(ctx, o2.ID.Hex(), "interrupted", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if ack, err := s.PaymentNotify(ctx, headers2, body2); err != nil || ack.Code != "SUCCESS" {
+		t.Fatalf("retry notify ack = %v, error = %v", ack, err)
+	}
+	status(o2.ID.Hex(), order.StatusFulfilled)
+	check(23)
+
+	// 发放过次数但未标记到账的订单, 由定时任务标记到账且不重复发放
+	o3, _, err := s.create(ctx, userId, "credits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.OrderMapper.Pay(ctx, o3.ID.Hex(), "interrupted", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.fulfill(ctx, o3); err != nil {
+		t.Fatal(err)
+	}
+	check(33)
+	s.Clock = clock.Fixed(time.Now().Add(2 * stuckAfter))
+	if err = s.Sweep(ctx); err != nil {
+		t.Fatal(err)
+	}
+	status(o3.ID.Hex(), order.StatusFulfilled)
+	check(33)
+
+	// 退款收回次数, 重复退款被拒绝
+	if err = s.refund(ctx, o.ID.Hex(), "test"); err != nil {
+		t.Fatal(err)
+	}
+	status(o.ID.Hex(), order.StatusRefunded)
+	check(23)
+	if err = s.refund(ctx, o.ID.Hex(), "test"); err == nil {
+		t.Fatal("refund twice should fail")
+	}
+	check(23)
+
+	// 发放的次数已被使用时拒绝退款
+	if _, err = quota.Change(ctx, userId, -18, ledger.ReasonEvaluate, "test"); err != nil {
+		t.Fatal(err)
+	}
+	if err = s.refund(ctx, o2.ID.Hex(), "test"); !errors.Is(err, consts.ErrRefundConsumed) {
+		t.Fatalf("refund consumed order error %v, want ErrRefundConsumed", err)
+	}
+	status(o2.ID.Hex(), order.StatusFulfilled)
+	check(5)
+}
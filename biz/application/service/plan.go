@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/entitlement"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"time"
+)
+
+type IPlanService interface {
+	GrantPlan(ctx context.Context, req *show.GrantPlanReq) (*show.Response, error)
+	Grant(ctx context.Context, userId, planId, refId string) error
+	Revoke(ctx context.Context, userId, refId string) error
+	Available(ctx context.Context, userId string) (bool, error)
+	Consume(ctx context.Context, userId string) (bool, error)
+	Entitlements(ctx context.Context, userId string) (plan string, dtos []*show.Entitlement, err error)
+	ResetMonthly(ctx context.Context) error
+}
+
+// PlanService 管理套餐权益, 批改时按entitlement.ConsumeOrder优先消耗权益
+type PlanService struct {
+	EntitlementMapper   *entitlement.MongoMapper
+	UserMapper          *user.MongoMapper
+	NotificationService INotificationService
+	Clock               clock.Clock
+}
+
+var PlanServiceSet = wire.NewSet(
+	wire.Struct(new(PlanService), "*"),
+	wire.Bind(new(IPlanService), new(*PlanService)),
+)
+
+// GrantPlan 管理员为用户开通套餐
+func (s *PlanService) GrantPlan(ctx context.Context, req *show.GrantPlanReq) (*show.Response, error) {
+	// 仅管理员可用
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.UserMapper.FindOne(ctx, req.UserId); err != nil {
+		return nil, consts.ErrNotFound
+	}
+	if err := s.Grant(ctx, req.UserId, req.PlanId, ""); err != nil {
+		return nil, err
+	}
+	if err := s.NotificationService.Notify(ctx, &notification.Notification{
+		UserId:  req.UserId,
+		Type:    notification.TypeQuotaGranted,
+		Title:   "套餐已开通",
+		Content: "你的套餐已开通，可以在个人中心查看权益",
+		RefId:   req.PlanId,
+	}); err != nil {
+		logx.CtxError(ctx, "plan: notify grant of %s error %v", req.UserId, err)
+	}
+	return util.Succeed("开通成功")
+}
+
+// Grant 为用户开通套餐, 权益从当前时间开始生效, refId记录开通来源, 同一来源只开通一次
+func (s *PlanService) Grant(ctx context.Context, userId, planId, refId string) error {
+	var plan *config.Plan
+	for i, p := range config.GetConfig().Plans {
+		if p.Id == planId {
+			plan = &config.GetConfig().Plans[i]
+			break
+		}
+	}
+	if plan == nil {
+		return consts.ErrPlanNotFound
+	}
+
+	now := s.Clock.Now()
+	e := &entitlement.Entitlement{
+		UserId:    userId,
+		PlanId:    plan.Id,
+		Name:      plan.Name,
+		Type:      plan.Type,
+		StartTime: now,
+		EndTime:   now.AddDate(0, 0, int(plan.Days)),
+		RefId:     refId,
+	}
+	switch plan.Type {
+	case entitlement.TypeUnlimited:
+	case entitlement.TypeMonthly:
+		e.Total, e.Remaining = plan.Credits, plan.Credits
+		e.ResetTime = nextReset(now, now)
+	case entitlement.TypeExpiring:
+		e.Total, e.Remaining = plan.Credits, plan.Credits
+	default:
+		return consts.ErrPlanNotFound
+	}
+	// 同一来源重复开通时视为已开通, 重试发放订单权益时不会重复开通
+	if err := s.EntitlementMapper.Insert(ctx, e); err != nil && !errors.Is(err, consts.ErrEntitlementExists) {
+		return err
+	}
+	return nil
+}
+
+// Revoke 收回来源为refId的套餐权益, 用于订单退款
+func (s *PlanService) Revoke(ctx context.Context, userId, refId string) error {
+	return s.EntitlementMapper.Revoke(ctx, userId, refId, s.Clock.Now())
+}
+
+// Available 判断用户是否有可用于批改的权益
+func (s *PlanService) Available(ctx context.Context, userId string) (bool, error) {
+	es, err := s.EntitlementMapper.FindActive(ctx, userId, s.Clock.Now())
+	if err != nil {
+		return false, err
+	}
+	for _, e := range es {
+		if e.Type == entitlement.TypeUnlimited || e.Remaining > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Consume 按顺序消耗一次权益, 没有可用权益时返回false, 由调用方扣除User.Count
+func (s *PlanService) Consume(ctx context.Context, userId string) (bool, error) {
+	now := s.Clock.Now()
+	for _, t := range entitlement.ConsumeOrder {
+		_, err := s.EntitlementMapper.Use(ctx, userId, t, now)
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, consts.ErrNotFound) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// Entitlements 获取用户生效中的权益, plan为优先级最高的套餐名称
+func (s *PlanService) Entitlements(ctx context.Context, userId string) (plan string, dtos []*show.Entitlement, err error) {
+	es, err := s.EntitlementMapper.FindActive(ctx, userId, s.Clock.Now())
+	if err != nil {
+		return "", nil, err
+	}
+
+	// 按消耗顺序排列
+	dtos = make([]*show.Entitlement, 0, len(es))
+	for _, t := range entitlement.ConsumeOrder {
+		for _, e := range es {
+			if e.Type != t {
+				continue
+			}
+			if plan == "" && t != entitlement.TypeExpiring {
+				plan = e.Name
+			}
+			dto := &show.Entitlement{
+				Id:        e.ID.Hex(),
+				Name:      e.Name,
+				Type:      e.Type,
+				Total:     e.Total,
+				Remaining: e.Remaining,
+				StartTime: e.StartTime.Unix(),
+				EndTime:   e.EndTime.Unix(),
+			}
+			if t == entitlement.TypeUnlimited {
+				dto.Remaining = -1
+			}
+			if !e.ResetTime.IsZero() {
+				dto.ResetTime = e.ResetTime.Unix()
+			}
+			dtos = append(dtos, dto)
+		}
+	}
+	return plan, dtos, nil
+}
+
+// ResetMonthly 重置到期的每月额度, 由定时任务调用
+func (s *PlanService) ResetMonthly(ctx context.Context) error {
+	now := s.Clock.Now()
+	es, err := s.EntitlementMapper.FindDue(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, e := range es {
+		if err = s.EntitlementMapper.Reset(ctx, e, nextReset(e.StartTime.In(now.Location()), now)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nextReset 返回每月额度在t之后的下一个重置时间, 即开通时间start每月的同一天同一时刻, 当月没有这一天时取月末
+func nextReset(start, t time.Time) time.Time {
+	for n := 1; ; n++ {
+		first := time.Date(start.Year(), start.Month()+time.Month(n), 1, 0, 0, 0, 0, start.Location())
+		day := min(start.Day(), first.AddDate(0, 1, -1).Day())
+		reset := time.Date(first.Year(), first.Month(), day, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+		if reset.After(t) {
+			return reset
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+// 每月额度按开通日期重置, 当月没有开通的那一天时在月末重置
+func TestNextReset(t *testing.T) {
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	cases := []struct {
+		name  string
+		start time.Time
+		now   time.Time
+		want  time.Time
+	}{
+		{"grant", at(1, 15, 10), at(1, 15, 10), at(2, 15, 10)},
+		{"grant on 31st", at(1, 31, 10), at(1, 31, 10), at(2, 28, 10)},
+		{"after short month", at(1, 31, 10), at(2, 28, 10), at(3, 31, 10)},
+		{"30 day month", at(1, 31, 10), at(3, 31, 10), at(4, 30, 10)},
+		{"late sweep", at(1, 15, 10), at(3, 20, 0), at(4, 15, 10)},
+		{"before anniversary", at(1, 15, 10), at(2, 15, 9), at(2, 15, 10)},
+	}
+	for _, c := range cases {
+		if got := nextReset(c.start, c.now); !got.Equal(c.want) {
+			t.Errorf("%s: nextReset = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/prompt"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+)
+
+type IPromptService interface {
+	ListPrompts(ctx context.Context, req *show.ListPromptsReq) (*show.ListPromptsResp, error)
+	GetPrompt(ctx context.Context, req *show.GetPromptReq) (*show.GetPromptResp, error)
+	CreatePrompt(ctx context.Context, req *show.CreatePromptReq) (*show.CreatePromptResp, error)
+	UpdatePrompt(ctx context.Context, req *show.UpdatePromptReq) (*show.Response, error)
+	UpdatePromptStatus(ctx context.Context, req *show.UpdatePromptStatusReq) (*show.Response, error)
+	ListAllPrompts(ctx context.Context, req *show.ListPromptsReq) (*show.ListPromptsResp, error)
+	Online(ctx context.Context, id string) (*prompt.Prompt, error)
+}
+
+// PromptService 管理作文题库, 管理员维护题目和范文, 学生按年级和体裁浏览并选题批改
+type PromptService struct {
+	PromptMapper *prompt.MongoMapper
+}
+
+var PromptServiceSet = wire.NewSet(
+	wire.Struct(new(PromptService), "*"),
+	wire.Bind(new(IPromptService), new(*PromptService)),
+)
+
+// ListPrompts 按年级和体裁分页浏览上架的题目, 列表中不返回范文
+func (s *PromptService) ListPrompts(ctx context.Context, req *show.ListPromptsReq) (*show.ListPromptsResp, error) {
+	status := int64(prompt.StatusOnline)
+	return s.list(ctx, req, &status)
+}
+
+// GetPrompt 获取上架题目的详情和范文
+func (s *PromptService) GetPrompt(ctx context.Context, req *show.GetPromptReq) (*show.GetPromptResp, error) {
+	p, err := s.Online(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &show.GetPromptResp{
+		Code:   0,
+		Msg:    "success",
+		Prompt: promptDTO(p, true),
+	}, nil
+}
+
+// CreatePrompt 管理员创建题目
+func (s *PromptService) CreatePrompt(ctx context.Context, req *show.CreatePromptReq) (*show.CreatePromptResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p := &prompt.Prompt{
+		Title:       req.Title,
+		Instruction: req.Instruction,
+		Grade:       req.Grade,
+		EssayType:   req.EssayType,
+		MinWords:    req.MinWords,
+		MaxWords:    req.MaxWords,
+		Models:      models(req.Models),
+		Status:      prompt.StatusOnline,
+	}
+	if !validPrompt(p) {
+		return nil, consts.ErrInvalidParams
+	}
+	if err := s.PromptMapper.Insert(ctx, p); err != nil {
+		return nil, err
+	}
+	return &show.CreatePromptResp{
+		Code:   0,
+		Msg:    "创建成功",
+		Prompt: promptDTO(p, true),
+	}, nil
+}
+
+// UpdatePrompt 管理员修改题目内容
+func (s *PromptService) UpdatePrompt(ctx context.Context, req *show.UpdatePromptReq) (*show.Response, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	p, err := s.PromptMapper.FindOne(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	p.Title = req.Title
+	p.Instruction = req.Instruction
+	p.Grade = req.Grade
+	p.EssayType = req.EssayType
+	p.MinWords = req.MinWords
+	p.MaxWords = req.MaxWords
+	p.Models = models(req.Models)
+	if !validPrompt(p) {
+		return nil, consts.ErrInvalidParams
+	}
+	if err = s.PromptMapper.Update(ctx, p); err != nil {
+		return nil, err
+	}
+	return util.Succeed("修改成功")
+}
+
+// UpdatePromptStatus 管理员上架或下架题目
+func (s *PromptService) UpdatePromptStatus(ctx context.Context, req *show.UpdatePromptStatusReq) (*show.Response, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.Status != prompt.StatusOnline && req.Status != prompt.StatusOffline {
+		return nil, consts.ErrInvalidParams
+	}
+	if err := s.PromptMapper.UpdateStatus(ctx, req.Id, req.Status); err != nil {
+		return nil, err
+	}
+	return util.Succeed("修改成功")
+}
+
+// ListAllPrompts 管理员分页查看所有题目, 可以按状态筛选
+func (s *PromptService) ListAllPrompts(ctx context.Context, req *show.ListPromptsReq) (*show.ListPromptsResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.list(ctx, req, req.Status)
+}
+
+// Online 获取上架的题目, 下架的题目视为不存在
+func (s *PromptService) Online(ctx context.Context, id string) (*prompt.Prompt, error) {
+	p, err := s.PromptMapper.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != prompt.StatusOnline {
+		return nil, consts.ErrNotFound
+	}
+	return p, nil
+}
+
+func (s *PromptService) list(ctx context.Context, req *show.ListPromptsReq, status *int64) (*show.ListPromptsResp, error) {
+	ps, total, err := s.PromptMapper.FindMany(ctx, &prompt.Filter{
+		Grade:     req.GetGrade(),
+		EssayType: req.GetEssayType(),
+		Status:    status,
+	}, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.Prompt, 0, len(ps))
+	for _, p := range ps {
+		dtos = append(dtos, promptDTO(p, false))
+	}
+	return &show.ListPromptsResp{
+		Code:    0,
+		Msg:     "success",
+		Prompts: dtos,
+		Total:   total,
+	}, nil
+}
+
+func validPrompt(p *prompt.Prompt) bool {
+	return p.Title != "" && p.MinWords >= 0 && p.MaxWords >= 0 && (p.MaxWords == 0 || p.MaxWords >= p.MinWords)
+}
+
+func models(ms []*show.ModelEssay) []*prompt.Model {
+	res := make([]*prompt.Model, 0, len(ms))
+	for _, m := range ms {
+		res = append(res, &prompt.Model{Title: m.Title, Text: m.Text, Commentary: m.Commentary})
+	}
+	return res
+}
+
+// promptDTO 转换题目, withModels为false时不返回范文
+func promptDTO(p *prompt.Prompt, withModels bool) *show.Prompt {
+	dto := &show.Prompt{
+		Id:          p.ID.Hex(),
+		Title:       p.Title,
+		Instruction: p.Instruction,
+		Grade:       p.Grade,
+		EssayType:   p.EssayType,
+		MinWords:    p.MinWords,
+		MaxWords:    p.MaxWords,
+		Models:      make([]*show.ModelEssay, 0),
+		Status:      p.Status,
+		CreateTime:  p.CreateTime.Unix(),
+		UpdateTime:  p.UpdateTime.Unix(),
+	}
+	if withModels {
+		for _, m := range p.Models {
+			dto.Models = append(dto.Models, &show.ModelEssay{Title: m.Title, Text: m.Text, Commentary: m.Commentary})
+		}
+	}
+	return dto
+}
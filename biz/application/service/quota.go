@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+)
+
+type IQuotaService interface {
+	GetQuotaHistory(ctx context.Context, req *show.GetQuotaHistoryReq) (*show.GetQuotaHistoryResp, error)
+	AuditQuota(ctx context.Context, req *show.AuditQuotaReq) (*show.AuditQuotaResp, error)
+	Open(ctx context.Context, userId string, balance int64, reason string) error
+	Change(ctx context.Context, userId string, delta int64, reason, refId string) (int64, error)
+	ChangeOnce(ctx context.Context, userId string, delta int64, reason, refId string) (int64, error)
+	Adjust(ctx context.Context, userId string, delta int64, adminId, note string) (int64, error)
+}
+
+// QuotaService 是修改用户剩余批改次数的唯一入口, 每次变动都会记入账本
+type QuotaService struct {
+	UserMapper   *user.MongoMapper
+	LedgerMapper *ledger.MongoMapper
+}
+
+var QuotaServiceSet = wire.NewSet(
+	wire.Struct(new(QuotaService), "*"),
+	wire.Bind(new(IQuotaService), new(*QuotaService)),
+)
+
+// GetQuotaHistory 分页获取自己的批改次数变动记录
+func (s *QuotaService) GetQuotaHistory(ctx context.Context, req *show.GetQuotaHistoryReq) (*show.GetQuotaHistoryResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	// 分页查询
+	es, total, err := s.LedgerMapper.FindMany(ctx, meta.GetUserId(), req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	// 构造响应
+	dtos := make([]*show.QuotaEntry, 0, len(es))
+	for _, e := range es {
+		dtos = append(dtos, &show.QuotaEntry{
+			Id:         e.ID.Hex(),
+			Delta:      e.Delta,
+			Reason:     e.Reason,
+			RefId:      e.RefId,
+			Balance:    e.Balance,
+			CreateTime: e.CreateTime.Unix(),
+		})
+	}
+	return &show.GetQuotaHistoryResp{
+		Code:    0,
+		Msg:     "success",
+		Entries: dtos,
+		Total:   total,
+	}, nil
+}
+
+// AuditQuota 核对用户的剩余次数与账本是否一致, 可选按账本重建
+func (s *QuotaService) AuditQuota(ctx context.Context, req *show.AuditQuotaReq) (*show.AuditQuotaResp, error) {
+	// 仅管理员可用
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.UserMapper.FindOne(ctx, req.UserId)
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+	sum, err := s.LedgerMapper.Sum(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &show.AuditQuotaResp{
+		Code:          0,
+		Msg:           "success",
+		Balance:       u.Count,
+		LedgerBalance: sum,
+		Consistent:    u.Count == sum,
+	}
+	if resp.Consistent || !req.Rebuild {
+		return resp, nil
+	}
+
+	// 账本缺少期初记录时无法据此重建, 改为按当前次数补记期初记录, 使账本与剩余次数一致
+	opened, err := s.LedgerMapper.HasOpening(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !opened {
+		if _, err = s.LedgerMapper.Open(ctx, &ledger.Entry{
+			UserId:  req.UserId,
+			Delta:   u.Count - sum,
+			Reason:  ledger.ReasonInit,
+			RefId:   adminId,
+			Balance: u.Count - sum,
+		}); err != nil {
+			return nil, err
+		}
+		resp.LedgerBalance, resp.Consistent = u.Count, true
+		return resp, nil
+	}
+
+	// 以账本为准重建剩余次数, 并记录一条不影响合计的审计记录
+	if err = s.UserMapper.SetCount(ctx, req.UserId, sum); err != nil {
+		return nil, consts.ErrUpdate
+	}
+	if err = s.LedgerMapper.Insert(ctx, &ledger.Entry{
+		UserId:  req.UserId,
+		Reason:  ledger.ReasonAudit,
+		RefId:   adminId,
+		Balance: sum,
+	}); err != nil {
+		logx.CtxError(ctx, "quota: audit entry for %s error %v", req.UserId, err)
+	}
+	resp.Balance = sum
+	return resp, nil
+}
+
+// Open 为用户记录期初次数, 不改变剩余次数, 已有期初记录时不再写入
+func (s *QuotaService) Open(ctx context.Context, userId string, balance int64, reason string) error {
+	_, err := s.LedgerMapper.Open(ctx, &ledger.Entry{
+		UserId:  userId,
+		Delta:   balance,
+		Reason:  reason,
+		Balance: balance,
+	})
+	return err
+}
+
+// Change 增减用户的剩余次数并记账, 返回变动后的次数
+func (s *QuotaService) Change(ctx context.Context, userId string, delta int64, reason, refId string) (int64, error) {
+	return s.change(ctx, &ledger.Entry{
+		UserId: userId,
+		Delta:  delta,
+		Reason: reason,
+		RefId:  refId,
+	})
+}
+
+// ChangeOnce 与Change相同, 但同一原因和refId只生效一次, 用于失败后会重试的发放
+// 次数和账本分别按refId去重, 中途失败时重试会补齐未完成的一步, 因此记账失败时返回错误以便重试
+func (s *QuotaService) ChangeOnce(ctx context.Context, userId string, delta int64, reason, refId string) (int64, error) {
+	if err := s.ensureOpening(ctx, userId); err != nil {
+		logx.CtxError(ctx, "quota: open ledger for %s error %v", userId, err)
+		return 0, err
+	}
+
+	balance, err := s.UserMapper.UpdateCountOnce(ctx, userId, delta, reason+":"+refId)
+	if err != nil {
+		return 0, err
+	}
+	if err = s.LedgerMapper.InsertOnce(ctx, &ledger.Entry{
+		UserId:  userId,
+		Delta:   delta,
+		Reason:  reason,
+		RefId:   refId,
+		Balance: balance,
+	}); err != nil {
+		logx.CtxError(ctx, "quota: ledger %s %d for %s error %v", reason, delta, userId, err)
+		return 0, err
+	}
+	return balance, nil
+}
+
+// Adjust 管理员手动增减用户的剩余次数, 调整原因记入账本
+func (s *QuotaService) Adjust(ctx context.Context, userId string, delta int64, adminId, note string) (int64, error) {
+	return s.change(ctx, &ledger.Entry{
+		UserId: userId,
+		Delta:  delta,
+		Reason: ledger.ReasonAdmin,
+		RefId:  adminId,
+		Note:   note,
+	})
+}
+
+// change 按变动记录增减剩余次数并记账
+// 记账失败时撤回本次变动并返回错误, 使剩余次数与账本保持一致
+func (s *QuotaService) change(ctx context.Context, e *ledger.Entry) (int64, error) {
+	// 启用账本前注册的用户, 首次变动前先补记当前的次数, 并发的首次变动只会写入一条期初记录
+	if err := s.ensureOpening(ctx, e.UserId); err != nil {
+		logx.CtxError(ctx, "quota: open ledger for %s error %v", e.UserId, err)
+		return 0, err
+	}
+
+	balance, err := s.UserMapper.UpdateCount(ctx, e.UserId, e.Delta)
+	if err != nil {
+		return 0, err
+	}
+	e.Balance = balance
+	if err = s.LedgerMapper.Insert(ctx, e); err != nil {
+		logx.CtxError(ctx, "quota: ledger %s %d for %s error %v", e.Reason, e.Delta, e.UserId, err)
+		if _, rerr := s.UserMapper.UpdateCount(ctx, e.UserId, -e.Delta); rerr != nil {
+			logx.CtxError(ctx, "quota: revert %s %d for %s error %v", e.Reason, e.Delta, e.UserId, rerr)
+		}
+		return 0, err
+	}
+	return balance, nil
+}
+
+// ensureOpening 用户没有期初记录时按当前次数补记
+func (s *QuotaService) ensureOpening(ctx context.Context, userId string) error {
+	ok, err := s.LedgerMapper.HasOpening(ctx, userId)
+	if err != nil || ok {
+		return err
+	}
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil {
+		return err
+	}
+	return s.Open(ctx, userId, u.Count, ledger.ReasonInit)
+}
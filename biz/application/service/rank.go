@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/exercise"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/rank"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type IRankService interface {
+	GetRank(ctx context.Context, req *show.GetRankReq) (*show.GetRankResp, error)
+	UpdateRankPrivacy(ctx context.Context, req *show.UpdateRankPrivacyReq) (*show.Response, error)
+	Rebuild(ctx context.Context) error
+	OnExercise(ctx context.Context, userId string, score int64)
+	OnAttend(ctx context.Context, userId string)
+	Remove(ctx context.Context, u *user.User) error
+}
+
+// RankService 维护按学校和年级划分的周榜与月榜
+// 练习与签到时增量更新榜单, 并由定时任务根据数据库全量重建, 以修正增量更新的偏差
+type RankService struct {
+	RankMapper     *rank.RedisMapper
+	UserMapper     *user.MongoMapper
+	ExerciseMapper *exercise.MongoMapper
+	AttendMapper   *attend.MongoMapper
+	Clock          clock.Clock
+}
+
+var RankServiceSet = wire.NewSet(
+	wire.Struct(new(RankService), "*"),
+	wire.Bind(new(IRankService), new(*RankService)),
+)
+
+// GetRank 获取排行榜前N名及自己的排名
+func (s *RankService) GetRank(ctx context.Context, req *show.GetRankReq) (*show.GetRankResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	// 校验参数
+	if !util.Contains(rank.Boards, req.Board) || !util.Contains(rank.Periods, req.Period) || !util.Contains(rank.Scopes, req.Scope) {
+		return nil, consts.ErrInvalidParams
+	}
+	top := req.GetTop()
+	if top <= 0 {
+		top = consts.DefaultRankTop
+	} else if top > consts.MaxRankTop {
+		top = consts.MaxRankTop
+	}
+
+	u, err := s.UserMapper.FindOne(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+
+	resp := &show.GetRankResp{
+		Code:  0,
+		Msg:   "success",
+		Items: make([]*show.RankItem, 0),
+	}
+
+	// 未填写学校或年级时没有对应的榜单
+	value, ok := scopeValue(u, req.Scope)
+	if !ok {
+		return resp, nil
+	}
+	key := rank.Key(req.Board, req.Period, s.Clock.Now(), req.Scope, value)
+
+	// 前N名
+	items, err := s.RankMapper.Top(ctx, key, top)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.Member)
+	}
+	us, err := s.UserMapper.FindManyByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string]*user.User, len(us))
+	for _, v := range us {
+		users[v.ID.Hex()] = v
+	}
+	for _, item := range items {
+		dto := &show.RankItem{Rank: item.Rank, Score: item.Score}
+		if v, ok := users[item.Member]; ok {
+			dto.Avatar = v.Avatar
+			dto.Name = util.MaskName(v.Username)
+			if item.Member == u.ID.Hex() {
+				dto.Name = v.Username
+			}
+		}
+		resp.Items = append(resp.Items, dto)
+	}
+
+	// 自己的排名
+	if !u.RankHidden {
+		mine, err := s.RankMapper.Find(ctx, key, u.ID.Hex())
+		if err != nil && !errors.Is(err, consts.ErrNotFound) {
+			return nil, err
+		}
+		if mine != nil {
+			resp.Mine = &show.RankItem{
+				Rank:   mine.Rank,
+				Name:   u.Username,
+				Avatar: u.Avatar,
+				Score:  mine.Score,
+			}
+		}
+	}
+	return resp, nil
+}
+
+// UpdateRankPrivacy 设置是否在排行榜中隐藏自己, 隐藏后立即从当前榜单中移除
+func (s *RankService) UpdateRankPrivacy(ctx context.Context, req *show.UpdateRankPrivacyReq) (*show.Response, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	u, err := s.UserMapper.FindOne(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+	if err = s.UserMapper.UpdateRankHidden(ctx, u.ID.Hex(), req.Hidden); err != nil {
+		return nil, consts.ErrUpdate
+	}
+
+	// 隐藏时从所有当前榜单中移除, 取消隐藏后在下次练习、签到或重建时重新上榜
+	if req.Hidden {
+		if err = s.Remove(ctx, u); err != nil {
+			return nil, err
+		}
+	}
+	return util.Succeed("设置成功")
+}
+
+// Remove 将用户从所有当前榜单中移除
+func (s *RankService) Remove(ctx context.Context, u *user.User) error {
+	keys := make([]string, 0)
+	for _, board := range rank.Boards {
+		keys = append(keys, userKeys(u, board, s.Clock.Now())...)
+	}
+	return s.RankMapper.Remove(ctx, u.ID.Hex(), keys...)
+}
+
+// OnExercise 用户首次提交一套练习后增加练习得分
+func (s *RankService) OnExercise(ctx context.Context, userId string, score int64) {
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil || u.RankHidden {
+		return
+	}
+	for _, key := range userKeys(u, rank.BoardExercise, s.Clock.Now()) {
+		if err = s.RankMapper.Incr(ctx, key, userId, score); err != nil {
+			logx.CtxError(ctx, "rank: incr %s error %v", key, err)
+		}
+	}
+}
+
+// OnAttend 用户签到后更新本周期内的最长连续签到天数
+func (s *RankService) OnAttend(ctx context.Context, userId string) {
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil || u.RankHidden {
+		return
+	}
+	now := s.Clock.Now()
+	for _, period := range rank.Periods {
+		start, end := rank.PeriodRange(period, now)
+		as, err := s.AttendMapper.FindBetween(ctx, userId, start, end)
+		if err != nil {
+			logx.CtxError(ctx, "rank: find attend error %v", err)
+			continue
+		}
+		streak := attend.LongestStreak(as, now.Location())
+		for _, scope := range rank.Scopes {
+			value, ok := scopeValue(u, scope)
+			if !ok {
+				continue
+			}
+			key := rank.Key(rank.BoardAttend, period, now, scope, value)
+			if err = s.RankMapper.SetMax(ctx, key, userId, streak); err != nil {
+				logx.CtxError(ctx, "rank: set %s error %v", key, err)
+			}
+		}
+	}
+}
+
+// Rebuild 根据数据库全量重建当前周期的所有榜单
+func (s *RankService) Rebuild(ctx context.Context) error {
+	now := s.Clock.Now()
+	for _, period := range rank.Periods {
+		start, end := rank.PeriodRange(period, now)
+
+		// 各用户的练习得分与最长连续签到天数
+		scores := map[string]map[string]int64{}
+		exerciseScores, err := s.ExerciseMapper.SumFirstScores(ctx, start, end)
+		if err != nil {
+			return err
+		}
+		scores[rank.BoardExercise] = exerciseScores
+
+		streaks, err := s.AttendMapper.LongestStreaks(ctx, start, end, now.Location())
+		if err != nil {
+			return err
+		}
+		scores[rank.BoardAttend] = streaks
+
+		// 查询涉及的用户, 按学校和年级划分榜单
+		ids := make([]string, 0, len(exerciseScores)+len(streaks))
+		for userId := range exerciseScores {
+			ids = append(ids, userId)
+		}
+		for userId := range streaks {
+			if _, ok := exerciseScores[userId]; !ok {
+				ids = append(ids, userId)
+			}
+		}
+		us, err := s.UserMapper.FindManyByIds(ctx, ids)
+		if err != nil {
+			return err
+		}
+		boards := make(map[string]map[string]int64)
+		for _, u := range us {
+			if u.RankHidden {
+				continue
+			}
+			for board, m := range scores {
+				score, ok := m[u.ID.Hex()]
+				if !ok {
+					continue
+				}
+				for _, scope := range rank.Scopes {
+					value, ok := scopeValue(u, scope)
+					if !ok {
+						continue
+					}
+					key := rank.Key(board, period, now, scope, value)
+					if boards[key] == nil {
+						boards[key] = make(map[string]int64)
+					}
+					boards[key][u.ID.Hex()] = score
+				}
+			}
+		}
+
+		// 清理本周期内已无成员的榜单, 如用户修改学校后的旧榜单
+		for _, board := range rank.Boards {
+			keys, err := s.RankMapper.Keys(ctx, "rank:"+board+":"+period+":"+rank.PeriodKey(period, now)+":")
+			if err != nil {
+				return err
+			}
+			for _, key := range keys {
+				if _, ok := boards[key]; !ok && !strings.HasSuffix(key, ":tmp") {
+					boards[key] = nil
+				}
+			}
+		}
+
+		// 写入榜单
+		for key, items := range boards {
+			if err = s.RankMapper.Replace(ctx, key, items); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scopeValue 返回用户在指定范围内所属的榜单, 未填写学校或年级时返回false
+func scopeValue(u *user.User, scope string) (string, bool) {
+	switch scope {
+	case rank.ScopeSchool:
+		return u.School, u.School != ""
+	case rank.ScopeGrade:
+		return strconv.FormatInt(u.Grade, 10), u.Grade > 0
+	default:
+		return "", false
+	}
+}
+
+// userKeys 返回用户当前所在的某类榜单的所有键
+func userKeys(u *user.User, board string, t time.Time) []string {
+	keys := make([]string, 0)
+	for _, period := range rank.Periods {
+		for _, scope := range rank.Scopes {
+			if value, ok := scopeValue(u, scope); ok {
+				keys = append(keys, rank.Key(board, period, t, scope, value))
+			}
+		}
+	}
+	return keys
+}
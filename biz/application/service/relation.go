@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/exercise"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/relation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"time"
+)
+
+// recentScores 学习统计中展示最近几次批改的得分
+const recentScores = 10
+
+type IRelationService interface {
+	CreateLinkCode(ctx context.Context, req *show.CreateLinkCodeReq) (*show.CreateLinkCodeResp, error)
+	ConfirmLink(ctx context.Context, req *show.ConfirmLinkReq) (*show.ConfirmLinkResp, error)
+	ListLinks(ctx context.Context, req *show.ListLinksReq) (*show.ListLinksResp, error)
+	RevokeLink(ctx context.Context, req *show.RevokeLinkReq) (*show.Response, error)
+	GetStudentStats(ctx context.Context, req *show.GetStudentStatsReq) (*show.GetStudentStatsResp, error)
+	Target(ctx context.Context, studentId string) (string, error)
+}
+
+// RelationService 管理家长与学生账号的绑定, 学生生成绑定码, 家长填写后完成绑定
+// 绑定后家长可以只读地查看学生的批改记录、练习、签到和学习统计, 学生可以随时解除绑定
+type RelationService struct {
+	RelationMapper      *relation.MongoMapper
+	CodeMapper          *relation.CodeRedisMapper
+	UserMapper          *user.MongoMapper
+	EvaluateLogMapper   *log.MongoMapper
+	ExerciseMapper      *exercise.MongoMapper
+	AttendMapper        *attend.MongoMapper
+	NotificationService INotificationService
+	Clock               clock.Clock
+}
+
+var RelationServiceSet = wire.NewSet(
+	wire.Struct(new(RelationService), "*"),
+	wire.Bind(new(IRelationService), new(*RelationService)),
+)
+
+// CreateLinkCode 学生生成绑定码
+func (s *RelationService) CreateLinkCode(ctx context.Context, req *show.CreateLinkCodeReq) (*show.CreateLinkCodeResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	u, err := s.UserMapper.FindOne(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+	if roleOf(u) != user.RoleStudent {
+		return nil, consts.ErrForbidden
+	}
+	rs, err := s.RelationMapper.FindManyByStudent(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(rs)) >= config.GetConfig().Relation.MaxParents {
+		return nil, consts.ErrRelationLimit
+	}
+
+	code, err := s.CodeMapper.Insert(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return &show.CreateLinkCodeResp{
+		Code:       0,
+		Msg:        "success",
+		LinkCode:   code,
+		ExpireTime: s.Clock.Now().Add(time.Duration(config.GetConfig().Relation.CodeExpire) * time.Second).Unix(),
+	}, nil
+}
+
+// ConfirmLink 家长填写学生的绑定码完成绑定, 并通知学生
+func (s *RelationService) ConfirmLink(ctx context.Context, req *show.ConfirmLinkReq) (*show.ConfirmLinkResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+	parentId := meta.GetUserId()
+
+	u, err := s.UserMapper.FindOne(ctx, parentId)
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+	if roleOf(u) != user.RoleParent {
+		return nil, consts.ErrForbidden
+	}
+
+	c := config.GetConfig().Relation
+	rs, err := s.RelationMapper.FindManyByParent(ctx, parentId)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(rs)) >= c.MaxStudents {
+		return nil, consts.ErrRelationLimit
+	}
+	studentId, err := s.CodeMapper.Take(ctx, parentId, req.LinkCode)
+	if err != nil {
+		return nil, err
+	}
+	if studentId == parentId {
+		return nil, consts.ErrLinkCode
+	}
+	if rs, err = s.RelationMapper.FindManyByStudent(ctx, studentId); err != nil {
+		return nil, err
+	}
+	if int64(len(rs)) >= c.MaxParents {
+		return nil, consts.ErrRelationLimit
+	}
+
+	r := &relation.Relation{ParentId: parentId, StudentId: studentId}
+	if err = s.RelationMapper.Insert(ctx, r); err != nil {
+		return nil, err
+	}
+	student, err := s.UserMapper.FindOne(ctx, studentId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.NotificationService.Notify(ctx, &notification.Notification{
+		UserId:  studentId,
+		Type:    notification.TypeRelation,
+		Title:   "家长已绑定你的账号",
+		Content: "家长可以查看你的批改记录、练习和签到，如非本人操作请在账号设置中解除绑定",
+		RefId:   parentId,
+	}); err != nil {
+		logx.CtxError(ctx, "relation: notify link of %s error %v", studentId, err)
+	}
+	return &show.ConfirmLinkResp{
+		Code:    0,
+		Msg:     "绑定成功",
+		Student: linkedUser(student, r),
+	}, nil
+}
+
+// ListLinks 获取绑定了自己的家长和自己绑定的学生
+func (s *RelationService) ListLinks(ctx context.Context, req *show.ListLinksReq) (*show.ListLinksResp, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	parents, err := s.RelationMapper.FindManyByStudent(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	students, err := s.RelationMapper.FindManyByParent(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &show.ListLinksResp{Code: 0, Msg: "success"}
+	if resp.Parents, err = s.linkedUsers(ctx, parents, func(r *relation.Relation) string { return r.ParentId }); err != nil {
+		return nil, err
+	}
+	if resp.Students, err = s.linkedUsers(ctx, students, func(r *relation.Relation) string { return r.StudentId }); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RevokeLink 解除绑定, 学生和家长都可以解除
+func (s *RelationService) RevokeLink(ctx context.Context, req *show.RevokeLinkReq) (*show.Response, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	err := s.RelationMapper.Delete(ctx, req.UserId, meta.GetUserId())
+	if errors.Is(err, consts.ErrNotFound) {
+		err = s.RelationMapper.Delete(ctx, meta.GetUserId(), req.UserId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return util.Succeed("已解除绑定")
+}
+
+// GetStudentStats 家长查看绑定学生的学习统计
+func (s *RelationService) GetStudentStats(ctx context.Context, req *show.GetStudentStatsReq) (*show.GetStudentStatsResp, error) {
+	studentId, err := s.Target(ctx, req.StudentId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &show.GetStudentStatsResp{Code: 0, Msg: "success", RecentScores: make([]int64, 0, recentScores)}
+	logs, err := s.EvaluateLogMapper.FindAllByUserId(ctx, studentId)
+	if err != nil {
+		return nil, err
+	}
+	scores := make([]int64, 0, len(logs))
+	var sum int64
+	for _, l := range logs {
+		if score, ok := l.Score(); ok {
+			scores = append(scores, score)
+			sum += score
+		}
+	}
+	resp.EvaluateCount = int64(len(logs))
+	if len(scores) > 0 {
+		resp.AverageScore = sum / int64(len(scores))
+		resp.RecentScores = append(resp.RecentScores, scores[max(len(scores)-recentScores, 0):]...)
+	}
+	if resp.ExerciseCount, err = s.ExerciseMapper.CountByUserId(ctx, studentId); err != nil {
+		return nil, err
+	}
+
+	// 签到
+	as, err := s.AttendMapper.FindAllByUserId(ctx, studentId)
+	if err != nil {
+		return nil, err
+	}
+	now := s.Clock.Now()
+	loc := now.Location()
+	if len(as) > 0 {
+		// 最近一次签到是今天或昨天时连续签到仍在延续
+		a := as[len(as)-1]
+		if !a.Day(loc).Before(attend.Day(now, loc).AddDate(0, 0, -1)) {
+			resp.Streak = max(a.Streak, 1)
+		}
+	}
+	resp.LongestStreak = attend.LongestStreak(as, loc)
+	return resp, nil
+}
+
+// Target 返回调用者要查看的学生id, studentId为空或为自己时查看自己的数据, 否则要求调用者是已绑定的家长
+func (s *RelationService) Target(ctx context.Context, studentId string) (string, error) {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return "", consts.ErrNotAuthentication
+	}
+	if studentId == "" || studentId == meta.GetUserId() {
+		return meta.GetUserId(), nil
+	}
+	_, err := s.RelationMapper.FindOne(ctx, meta.GetUserId(), studentId)
+	if errors.Is(err, consts.ErrNotFound) {
+		return "", consts.ErrForbidden
+	}
+	if err != nil {
+		return "", err
+	}
+	return studentId, nil
+}
+
+// linkedUsers 查询绑定关系另一方的用户信息, 已不存在的用户会被跳过
+func (s *RelationService) linkedUsers(ctx context.Context, rs []*relation.Relation, other func(r *relation.Relation) string) ([]*show.LinkedUser, error) {
+	ids := make([]string, 0, len(rs))
+	for _, r := range rs {
+		ids = append(ids, other(r))
+	}
+	us, err := s.UserMapper.FindManyByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byId := make(map[string]*user.User, len(us))
+	for _, u := range us {
+		byId[u.ID.Hex()] = u
+	}
+	dtos := make([]*show.LinkedUser, 0, len(rs))
+	for _, r := range rs {
+		if u, ok := byId[other(r)]; ok {
+			dtos = append(dtos, linkedUser(u, r))
+		}
+	}
+	return dtos, nil
+}
+
+func linkedUser(u *user.User, r *relation.Relation) *show.LinkedUser {
+	return &show.LinkedUser{
+		UserId:   u.ID.Hex(),
+		Name:     u.Username,
+		Avatar:   u.Avatar,
+		School:   u.School,
+		Grade:    u.Grade,
+		LinkTime: r.CreateTime.Unix(),
+	}
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/review"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"sort"
+)
+
+type IReviewService interface {
+	SaveReview(ctx context.Context, req *show.SaveReviewReq) (*show.SaveReviewResp, error)
+	GetReview(ctx context.Context, req *show.GetReviewReq) (*show.GetReviewResp, error)
+	ListReviewVersions(ctx context.Context, req *show.ListReviewVersionsReq) (*show.ListReviewVersionsResp, error)
+	DiffReview(ctx context.Context, req *show.DiffReviewReq) (*show.DiffReviewResp, error)
+}
+
+// ReviewService 管理教师对AI批改的人工批阅, 批阅以版本的形式叠加在批改结果之上, AI批改结果本身保持不变
+// 学生所在班级的教师和管理员可以批阅, 学生本人和绑定的家长可以查看
+type ReviewService struct {
+	ReviewMapper        *review.MongoMapper
+	LogMapper           *log.MongoMapper
+	UserMapper          *user.MongoMapper
+	ClassService        IClassService
+	RelationService     IRelationService
+	NotificationService INotificationService
+}
+
+var ReviewServiceSet = wire.NewSet(
+	wire.Struct(new(ReviewService), "*"),
+	wire.Bind(new(IReviewService), new(*ReviewService)),
+)
+
+// SaveReview 教师保存一个新的批阅版本, 基于的版本不是最新版本时保存失败, 避免覆盖他人的修改
+func (s *ReviewService) SaveReview(ctx context.Context, req *show.SaveReviewReq) (*show.SaveReviewResp, error) {
+	l, err := s.LogMapper.FindOne(ctx, req.LogId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.reviewable(ctx, l); err != nil {
+		return nil, err
+	}
+
+	ai := review.FromLog(l)
+	prev := ai
+	latest, err := s.ReviewMapper.FindLatest(ctx, req.LogId)
+	switch {
+	case err == nil:
+		prev = latest.Merge(ai)
+	case !errors.Is(err, consts.ErrNotFound):
+		return nil, err
+	}
+	if prev.Version != req.BaseVersion {
+		return nil, consts.ErrReviewConflict
+	}
+
+	r := &review.Review{
+		LogId:      req.LogId,
+		UserId:     l.UserId,
+		TeacherId:  adaptor.ExtractUserMeta(ctx).GetUserId(),
+		Version:    req.BaseVersion + 1,
+		Comments:   make([]*review.Comment, 0, len(req.Comments)),
+		Dimensions: make(map[string]int64, len(req.Dimensions)),
+		Score:      req.Score,
+		Summary:    req.Summary,
+	}
+	if r.Score != nil && *r.Score < 0 {
+		return nil, consts.ErrInvalidParams
+	}
+	for _, c := range req.Comments {
+		r.Comments = append(r.Comments, &review.Comment{
+			Paragraph: c.Paragraph,
+			Sentence:  c.Sentence,
+			Text:      c.Text,
+			Content:   c.Content,
+		})
+	}
+	for _, d := range req.Dimensions {
+		if d.Dimension == "" || d.Score < 0 {
+			return nil, consts.ErrInvalidParams
+		}
+		r.Dimensions[d.Dimension] = d.Score
+	}
+	r.Changes = review.Diff(prev, r.Merge(ai))
+	if err = s.ReviewMapper.Insert(ctx, r); err != nil {
+		return nil, err
+	}
+
+	if err = s.NotificationService.Notify(ctx, &notification.Notification{
+		UserId:  l.UserId,
+		Type:    notification.TypeReview,
+		Title:   "老师批阅了你的作文",
+		Content: "老师在AI批改的基础上添加了批注和评分，快去看看吧",
+		RefId:   req.LogId,
+	}); err != nil {
+		logx.CtxError(ctx, "review: notify %s error %v", l.UserId, err)
+	}
+	names, err := s.teacherNames(ctx, []*review.Review{r})
+	if err != nil {
+		return nil, err
+	}
+	return &show.SaveReviewResp{
+		Code:   0,
+		Msg:    "保存成功",
+		Review: reviewDTO(r.Merge(ai), ai, names),
+	}, nil
+}
+
+// GetReview 获取AI批改结果与最新批阅叠加后的结果
+func (s *ReviewService) GetReview(ctx context.Context, req *show.GetReviewReq) (*show.GetReviewResp, error) {
+	l, err := s.LogMapper.FindOne(ctx, req.LogId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.readable(ctx, l); err != nil {
+		return nil, err
+	}
+
+	ai := review.FromLog(l)
+	merged := ai
+	latest, err := s.ReviewMapper.FindLatest(ctx, req.LogId)
+	switch {
+	case err == nil:
+		merged = latest.Merge(ai)
+	case !errors.Is(err, consts.ErrNotFound):
+		return nil, err
+	}
+	names, err := s.teacherNames(ctx, []*review.Review{merged})
+	if err != nil {
+		return nil, err
+	}
+	aiScore := int64(-1)
+	if ai.Score != nil {
+		aiScore = *ai.Score
+	}
+	return &show.GetReviewResp{
+		Code:     0,
+		Msg:      "success",
+		Response: l.Response,
+		AiScore:  aiScore,
+		Review:   reviewDTO(merged, ai, names),
+	}, nil
+}
+
+// ListReviewVersions 获取批改的所有批阅版本及每个版本的修改人和修改内容
+func (s *ReviewService) ListReviewVersions(ctx context.Context, req *show.ListReviewVersionsReq) (*show.ListReviewVersionsResp, error) {
+	l, err := s.LogMapper.FindOne(ctx, req.LogId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.readable(ctx, l); err != nil {
+		return nil, err
+	}
+
+	rs, err := s.ReviewMapper.FindManyByLog(ctx, req.LogId)
+	if err != nil {
+		return nil, err
+	}
+	names, err := s.teacherNames(ctx, rs)
+	if err != nil {
+		return nil, err
+	}
+	ai := review.FromLog(l)
+	dtos := make([]*show.Review, 0, len(rs))
+	for _, r := range rs {
+		dtos = append(dtos, reviewDTO(r.Merge(ai), ai, names))
+	}
+	return &show.ListReviewVersionsResp{
+		Code:    0,
+		Msg:     "success",
+		Reviews: dtos,
+	}, nil
+}
+
+// DiffReview 比较两个版本, 版本0表示AI批改结果
+func (s *ReviewService) DiffReview(ctx context.Context, req *show.DiffReviewReq) (*show.DiffReviewResp, error) {
+	l, err := s.LogMapper.FindOne(ctx, req.LogId)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.readable(ctx, l); err != nil {
+		return nil, err
+	}
+
+	ai := review.FromLog(l)
+	from, err := s.version(ctx, ai, req.From)
+	if err != nil {
+		return nil, err
+	}
+	var to *review.Review
+	if req.To != nil {
+		to, err = s.version(ctx, ai, *req.To)
+	} else if to, err = s.ReviewMapper.FindLatest(ctx, req.LogId); err == nil {
+		to = to.Merge(ai)
+	} else if errors.Is(err, consts.ErrNotFound) {
+		to, err = ai, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &show.DiffReviewResp{
+		Code:    0,
+		Msg:     "success",
+		From:    from.Version,
+		To:      to.Version,
+		Changes: changeDTOs(review.Diff(from, to)),
+	}, nil
+}
+
+// version 获取叠加后的某个版本, 版本0为AI批改结果
+func (s *ReviewService) version(ctx context.Context, ai *review.Review, v int64) (*review.Review, error) {
+	if v == 0 {
+		return ai, nil
+	}
+	r, err := s.ReviewMapper.FindOneByVersion(ctx, ai.LogId, v)
+	if err != nil {
+		return nil, err
+	}
+	return r.Merge(ai), nil
+}
+
+// reviewable 判断调用者是否可以批阅, 只有学生所在班级的教师和管理员可以批阅
+func (s *ReviewService) reviewable(ctx context.Context, l *log.Log) error {
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return consts.ErrNotAuthentication
+	}
+	if adaptor.ExtractRole(ctx) == user.RoleAdmin {
+		return nil
+	}
+	ok, err := s.ClassService.Teaches(ctx, meta.GetUserId(), l.UserId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return consts.ErrForbidden
+	}
+	return nil
+}
+
+// readable 判断调用者是否可以查看批阅, 学生本人、绑定的家长和可以批阅的教师都可以查看
+func (s *ReviewService) readable(ctx context.Context, l *log.Log) error {
+	if _, err := s.RelationService.Target(ctx, l.UserId); !errors.Is(err, consts.ErrForbidden) {
+		return err
+	}
+	return s.reviewable(ctx, l)
+}
+
+// teacherNames 查询批阅教师的用户名
+func (s *ReviewService) teacherNames(ctx context.Context, rs []*review.Review) (map[string]string, error) {
+	ids := make([]string, 0, len(rs))
+	for _, r := range rs {
+		if r.TeacherId != "" {
+			ids = append(ids, r.TeacherId)
+		}
+	}
+	names := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+	us, err := s.UserMapper.FindManyByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range us {
+		names[u.ID.Hex()] = u.Username
+	}
+	return names, nil
+}
+
+func reviewDTO(r, ai *review.Review, names map[string]string) *show.Review {
+	dto := &show.Review{
+		LogId:       r.LogId,
+		Version:     r.Version,
+		TeacherId:   r.TeacherId,
+		TeacherName: names[r.TeacherId],
+		Comments:    make([]*show.ReviewComment, 0, len(r.Comments)),
+		Dimensions:  make([]*show.DimensionScore, 0, len(r.Dimensions)),
+		Score:       -1,
+		Summary:     r.Summary,
+		Changes:     changeDTOs(r.Changes),
+		CreateTime:  r.CreateTime.Unix(),
+	}
+	if r.Score != nil {
+		dto.Score = *r.Score
+	}
+	for _, c := range r.Comments {
+		dto.Comments = append(dto.Comments, &show.ReviewComment{
+			Paragraph: c.Paragraph,
+			Sentence:  c.Sentence,
+			Text:      c.Text,
+			Content:   c.Content,
+		})
+	}
+	for k, v := range r.Dimensions {
+		aiScore, ok := ai.Dimensions[k]
+		if !ok {
+			aiScore = -1
+		}
+		dto.Dimensions = append(dto.Dimensions, &show.DimensionScore{Dimension: k, AiScore: aiScore, Score: v})
+	}
+	sort.Slice(dto.Dimensions, func(i, j int) bool { return dto.Dimensions[i].Dimension < dto.Dimensions[j].Dimension })
+	return dto
+}
+
+func changeDTOs(cs []*review.Change) []*show.ReviewChange {
+	dtos := make([]*show.ReviewChange, 0, len(cs))
+	for _, c := range cs {
+		dtos = append(dtos, &show.ReviewChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	return dtos
+}
@@ -6,12 +6,22 @@ import (
 	"github.com/google/wire"
 	"github.com/xh-polaris/essay-show/biz/adaptor"
 	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/invitation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -23,16 +33,38 @@ type IUserService interface {
 	UpdatePassword(ctx context.Context, req *show.UpdatePasswordReq) (*show.UpdatePasswordResp, error)
 	DailyAttend(ctx context.Context, req *show.DailyAttendReq) (*show.Response, error)
 	GetDailyAttend(ctx context.Context, req *show.GetDailyAttendReq) (*show.GetDailyAttendResp, error)
+	MakeUpAttend(ctx context.Context, req *show.MakeUpAttendReq) (*show.Response, error)
+	Subscribe()
 	FillInvitationCode(ctx context.Context, req *show.FillInvitationCodeReq) (*show.Response, error)
 	GetInvitationCode(ctx context.Context, req *show.GetInvitationCodeReq) (*show.GetInvitationCodeResp, error)
+	ListInvitees(ctx context.Context, req *show.ListInviteesReq) (*show.ListInviteesResp, error)
+	ListInvitationReviews(ctx context.Context, req *show.ListInvitationReviewsReq) (*show.ListInvitationReviewsResp, error)
+	ReviewInvitation(ctx context.Context, req *show.ReviewInvitationReq) (*show.Response, error)
+	UpdateInvitationCode(ctx context.Context, req *show.UpdateInvitationCodeReq) (*show.Response, error)
+	SetUserRole(ctx context.Context, req *show.SetUserRoleReq) (*show.Response, error)
+	Role(ctx context.Context, userId string) (string, error)
+	SweepInvitations(ctx context.Context) error
 }
 type UserService struct {
-	UserMapper   *user.MongoMapper
-	AttendMapper *attend.MongoMapper
-	CodeMapper   *invitation.CodeMongoMapper
-	LogMapper    *invitation.LogMongoMapper
+	UserMapper        *user.MongoMapper
+	AttendMapper      *attend.MongoMapper
+	CodeMapper        *invitation.CodeMongoMapper
+	LogMapper         *invitation.LogMongoMapper
+	EvaluateLogMapper *log.MongoMapper
+	RankService       IRankService
+	Bus               *event.Bus
+	Clock             clock.Clock
+	QuotaService      IQuotaService
+	PlanService       IPlanService
+	RelationService   IRelationService
 }
 
+// customCodeRe 自定义邀请码只能包含大写字母和数字
+var customCodeRe = regexp.MustCompile(`^[A-Z0-9]{4,16}$`)
+
+// unpaidAfter 激活后超过该时间仍未发放完奖励的邀请由定时任务补发
+const unpaidAfter = 5 * time.Minute
+
 var UserServiceSet = wire.NewSet(
 	wire.Struct(new(UserService), "*"),
 	wire.Bind(new(IUserService), new(*UserService)),
@@ -94,6 +126,9 @@ func (s *UserService) SignUp(ctx context.Context, req *show.SignUpReq) (*show.Si
 	if err != nil {
 		return nil, consts.ErrSignUp
 	}
+	if err = s.QuotaService.Open(ctx, userId, u.Count, ledger.ReasonSignUp); err != nil {
+		logx.CtxError(ctx, "quota: open ledger for %s error %v", userId, err)
+	}
 
 	// 返回响应
 	return &show.SignUpResp{
@@ -155,9 +190,18 @@ func (s *UserService) SignIn(ctx context.Context, req *show.SignInReq) (*show.Si
 		if err != nil {
 			return nil, consts.ErrSignUp
 		}
+		if err = s.QuotaService.Open(ctx, userId, u.Count, ledger.ReasonSignUp); err != nil {
+			logx.CtxError(ctx, "quota: open ledger for %s error %v", userId, err)
+		}
 	} else if err != nil {
 		return nil, consts.ErrSignIn
 	}
+	if u.Status == consts.BanStatus {
+		return nil, consts.ErrUserBanned
+	}
+	if u.Status == consts.DeleteStatus {
+		return nil, consts.ErrUserDeleted
+	}
 
 	resp := &show.SignInResp{
 		Id:           userId,
@@ -188,14 +232,30 @@ func (s *UserService) GetUserInfo(ctx context.Context, req *show.GetUserInfoReq)
 		}, nil
 	}
 
+	// 套餐权益
+	plan, es, err := s.PlanService.Entitlements(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 冷静期内的注销申请
+	var deleteTime int64
+	if !u.DeleteTime.IsZero() {
+		deleteTime = u.DeleteTime.Unix()
+	}
+
 	return &show.GetUserInfoResp{
 		Code: 0,
 		Msg:  "查询成功",
 		Payload: &show.GetUserInfoResp_Payload{
-			Name:   u.Username,
-			Count:  u.Count,
-			Phone:  u.Phone,
-			Avatar: u.Avatar,
+			Name:         u.Username,
+			Count:        u.Count,
+			Phone:        u.Phone,
+			Avatar:       u.Avatar,
+			Plan:         plan,
+			Entitlements: es,
+			Role:         roleOf(u),
+			DeleteTime:   deleteTime,
 		},
 	}, nil
 }
@@ -221,7 +281,7 @@ func (s *UserService) UpdateUserInfo(ctx context.Context, req *show.UpdateUserIn
 	u.Avatar = req.Avatar
 
 	// 存入新的用户信息
-	err = s.UserMapper.Update(ctx, u)
+	err = s.UserMapper.UpdateInfo(ctx, u)
 	if err != nil {
 		return nil, consts.ErrUpdate
 	}
@@ -280,28 +340,44 @@ func (s *UserService) DailyAttend(ctx context.Context, req *show.DailyAttendReq)
 		return nil, consts.ErrDailyAttend
 	}
 
-	// 今日有签到记录且不是第一次签到
-	if a != nil && time.Unix(a.Timestamp.Unix(), 0).Day() == time.Now().Day() && !a.Timestamp.IsZero() {
+	// 今日已签到则拒绝, 昨天签到过则延续连续签到天数
+	now := s.Clock.Now()
+	streak, ok := attend.Next(a, now)
+	if !ok {
 		return nil, consts.ErrRepeatDailyAttend
 	}
 
-	// 插入新的签到记录
+	// 插入新的签到记录, 并发请求由(user_id, date)唯一索引保证只有一次成功
+	// 达到连续签到里程碑时, 里程碑随记录一起写入, 之后补签合并连续签到时不再重复奖励
 	_a := &attend.Attend{
 		ID:        primitive.NewObjectID(),
 		UserId:    meta.GetUserId(),
-		Timestamp: time.Now(),
+		Date:      attend.DateKey(now, now.Location()),
+		Timestamp: now,
+		Streak:    streak,
+	}
+	bonus := milestoneReward(streak)
+	if bonus > 0 {
+		_a.Milestones = []int64{streak}
 	}
 	err = s.AttendMapper.Insert(ctx, _a)
-	if err != nil {
+	if errors.Is(err, consts.ErrRepeatDailyAttend) {
+		return nil, err
+	} else if err != nil {
 		return nil, consts.ErrDailyAttend
 	}
 
-	// 增加次数
-	err = s.UserMapper.UpdateCount(ctx, meta.GetUserId(), consts.AttendReward)
+	// 增加次数, 达到连续签到里程碑时额外奖励
+	reward := config.GetConfig().Attend.Reward + bonus
+	_, err = s.QuotaService.Change(ctx, meta.GetUserId(), reward, ledger.ReasonAttend, _a.ID.Hex())
 	if err != nil {
 		return nil, consts.ErrDailyAttend
 	}
 
+	// 更新连续签到榜
+	s.RankService.OnAttend(ctx, meta.GetUserId())
+	s.Bus.Publish(ctx, &event.Event{Type: event.DailyAttended, UserId: meta.GetUserId()})
+
 	return util.Succeed("签到成功")
 }
 
@@ -312,37 +388,195 @@ func (s *UserService) GetDailyAttend(ctx context.Context, req *show.GetDailyAtte
 		Attend: 0,
 	}
 
-	// 用户信息
-	meta := adaptor.ExtractUserMeta(ctx)
-	if meta.GetUserId() == "" {
-		return nil, consts.ErrNotAuthentication
+	// 获取要查看的用户, 家长可以查看绑定学生的签到
+	userId, err := s.RelationService.Target(ctx, req.StudentId)
+	if err != nil {
+		return nil, err
 	}
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil {
+		return nil, consts.ErrNotFound
+	}
+	resp.MakeUpCards = u.MakeUpCard
 
 	// 获取最新的, 确定今天的更新状态
-	a, err := s.findAttend(ctx, meta.GetUserId())
+	a, err := s.findAttend(ctx, userId)
 	if err != nil {
 		return nil, err
 	}
-	if !a.Timestamp.IsZero() && time.Unix(a.Timestamp.Unix(), 0).Day() == time.Now().Day() {
+	now := s.Clock.Now()
+	loc := now.Location()
+	today := attend.Day(now, loc)
+	if !a.Timestamp.IsZero() && a.Day(loc).Equal(today) {
 		resp.Attend = 1
 	}
 
+	// 最近一次签到是今天或昨天时连续签到仍在延续
+	if !a.Timestamp.IsZero() && !a.Day(loc).Before(today.AddDate(0, 0, -1)) {
+		resp.Streak = max(a.Streak, 1)
+	}
+
 	// 获取所有的指定年月的所有签到记录
-	data, total, err := s.AttendMapper.FindByYearAndMonth(ctx, meta.GetUserId(), int(req.Year), int(req.Month))
+	data, total, err := s.AttendMapper.FindByYearAndMonth(ctx, userId, int(req.Year), int(req.Month), loc)
 	if err != nil {
 		return nil, err
 	}
 
 	dtos := make([]int64, 0, len(data))
+	makeUps := make([]int64, 0)
 	for _, d := range data {
-		dtos = append(dtos, int64(d.Timestamp.Day()))
+		dtos = append(dtos, int64(d.Day(loc).Day()))
+		if d.MakeUp {
+			makeUps = append(makeUps, int64(d.Day(loc).Day()))
+		}
 	}
 	resp.History = dtos
+	resp.MakeUpHistory = makeUps
 	resp.Total = total
 
 	return resp, nil
 }
 
+// MakeUpAttend 使用一张补签卡补签最近漏签的一天, 并重新计算之后的连续签到天数
+func (s *UserService) MakeUpAttend(ctx context.Context, req *show.MakeUpAttendReq) (*show.Response, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	// 只能补签最近几天内的日期
+	now := s.Clock.Now()
+	loc := now.Location()
+	day := time.Date(int(req.Year), time.Month(req.Month), int(req.Day), 0, 0, 0, 0, loc)
+	today := attend.Day(now, loc)
+	if !day.Before(today) || day.Before(today.AddDate(0, 0, -int(config.GetConfig().Attend.MakeUpDays))) {
+		return nil, consts.ErrMakeUp
+	}
+
+	// 当天已经签到过
+	as, err := s.AttendMapper.FindBetween(ctx, meta.GetUserId(), day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	if len(as) > 0 {
+		return nil, consts.ErrMakeUp
+	}
+
+	// 扣除补签卡并插入补签记录
+	if err = s.UserMapper.UpdateMakeUpCard(ctx, meta.GetUserId(), -1); err != nil {
+		return nil, err
+	}
+	err = s.AttendMapper.Insert(ctx, &attend.Attend{
+		ID:        primitive.NewObjectID(),
+		UserId:    meta.GetUserId(),
+		Date:      attend.DateKey(day, loc),
+		Timestamp: day,
+		MakeUp:    true,
+	})
+	if err != nil {
+		if err := s.UserMapper.UpdateMakeUpCard(ctx, meta.GetUserId(), 1); err != nil {
+			logx.CtxError(ctx, "refund make up card error %v", err)
+		}
+		if errors.Is(err, consts.ErrRepeatDailyAttend) {
+			return nil, consts.ErrMakeUp
+		}
+		return nil, consts.ErrDailyAttend
+	}
+
+	// 重新计算补签日及之后的连续签到天数
+	as, err = s.AttendMapper.FindBetween(ctx, meta.GetUserId(), day.AddDate(0, 0, -1), today.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	var prev *attend.Attend
+	var reached int64                     // 合并前各段连续签到达到过的最长天数, 其中的里程碑都已奖励过
+	run := make(map[int64]*attend.Attend) // 补签所在的连续签到中, 按新的连续签到天数索引的记录
+	ended := false
+	for _, a := range as {
+		streak := int64(1)
+		contiguous := prev != nil && prev.Day(loc).AddDate(0, 0, 1).Equal(a.Day(loc))
+		if contiguous {
+			streak = max(prev.Streak, 1) + 1
+		}
+		prev = a
+		if a.Day(loc).Before(day) {
+			reached = a.Streak
+			continue
+		}
+		if !contiguous && a.Day(loc).After(day) {
+			ended = true
+		}
+		if !ended {
+			reached = max(reached, a.Streak)
+			run[streak] = a
+		}
+		if a.Streak != streak {
+			a.Streak = streak
+			if err = s.AttendMapper.UpdateStreak(ctx, a.ID, streak); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// 补签使连续签到跨过新的里程碑时补发奖励, 里程碑记录在恰好达到该天数的记录上, 并发补签时也只奖励一次
+	var reward int64
+	for _, r := range config.GetConfig().Attend.StreakRewards {
+		a, ok := run[r.Days]
+		if !ok || r.Days <= reached {
+			continue
+		}
+		added, err := s.AttendMapper.AddMilestone(ctx, a.ID, r.Days)
+		if err != nil {
+			return nil, err
+		}
+		if added {
+			reward += r.Reward
+		}
+	}
+	if reward > 0 {
+		if _, err = s.QuotaService.Change(ctx, meta.GetUserId(), reward, ledger.ReasonAttend, attend.DateKey(day, loc)); err != nil {
+			return nil, err
+		}
+	}
+	s.RankService.OnAttend(ctx, meta.GetUserId())
+	s.Bus.Publish(ctx, &event.Event{Type: event.DailyAttended, UserId: meta.GetUserId()})
+
+	return util.Succeed("补签成功")
+}
+
+// Subscribe 订阅发放补签卡和激活邀请的事件, 服务启动时调用
+func (s *UserService) Subscribe() {
+	s.Bus.Subscribe(s.grantMakeUpCard, event.InvitationAccepted, event.AchievementEarned)
+	s.Bus.Subscribe(s.activateInvitation, event.EssayEvaluated)
+}
+
+// grantMakeUpCard 成功邀请或获得徽章时发放补签卡
+func (s *UserService) grantMakeUpCard(ctx context.Context, e *event.Event) error {
+	c := config.GetConfig().Attend
+	n := c.InvitationMakeUpCards
+	if e.Type == event.AchievementEarned {
+		n = c.AchievementMakeUpCards
+	}
+	if n <= 0 {
+		return nil
+	}
+	return s.UserMapper.UpdateMakeUpCard(ctx, e.UserId, n)
+}
+
+// milestoneReward 计算连续签到恰好达到days天时的里程碑奖励
+func milestoneReward(days int64) int64 {
+	var reward int64
+	for _, r := range config.GetConfig().Attend.StreakRewards {
+		if r.Days == days {
+			reward += r.Reward
+		}
+	}
+	return reward
+}
+
+// FillInvitationCode 填写邀请码, 被邀请者立即获得奖励, 邀请者的奖励在被邀请者完成首次批改后发放
+// 命中风控规则的邀请进入待审核状态, 审核通过前双方都不获得奖励
 func (s *UserService) FillInvitationCode(ctx context.Context, req *show.FillInvitationCodeReq) (*show.Response, error) {
 	// 用户信息
 	userMeta := adaptor.ExtractUserMeta(ctx)
@@ -351,10 +585,13 @@ func (s *UserService) FillInvitationCode(ctx context.Context, req *show.FillInvi
 	}
 
 	// 获取邀请码对应邀请者
-	c, err := s.CodeMapper.FindOneByCode(ctx, req.InvitationCode)
+	c, err := s.CodeMapper.FindOneByCode(ctx, strings.ToUpper(strings.TrimSpace(req.InvitationCode)))
 	if err != nil {
 		return nil, consts.ErrNotFound
 	}
+	if !c.Valid(s.Clock.Now()) {
+		return nil, consts.ErrInvitationExpired
+	}
 
 	inviter := c.UserId
 	invitee := userMeta.GetUserId()
@@ -373,19 +610,240 @@ func (s *UserService) FillInvitationCode(ctx context.Context, req *show.FillInvi
 		return nil, err
 	}
 
-	// 插入邀请记录
-	err = s.LogMapper.Insert(ctx, inviter, invitee)
+	// 检查风控规则
+	l = &invitation.Log{
+		Inviter:  inviter,
+		Invitee:  invitee,
+		Status:   invitation.StatusPending,
+		DeviceId: userMeta.GetSessionDeviceId(),
+		IP:       adaptor.ExtractExtra(ctx).GetClientIP(),
+	}
+	l.Risks, err = s.checkInvitation(ctx, c, l)
 	if err != nil {
 		return nil, consts.ErrInvitation
 	}
+	if len(l.Risks) > 0 {
+		l.Status = invitation.StatusReviewing
+	}
 
-	err = s.UserMapper.UpdateCount(ctx, inviter, consts.InvitationReward)
+	// 插入邀请记录, 并发填写时由唯一索引保证只有一次成功
+	err = s.LogMapper.Insert(ctx, l)
+	if errors.Is(err, consts.ErrRepeatInvitation) {
+		return nil, err
+	} else if err != nil {
+		return nil, consts.ErrInvitation
+	}
+	if l.Status == invitation.StatusReviewing {
+		logx.CtxInfo(ctx, "invitation: %s invited by %s needs review, risks %v", invitee, inviter, l.Risks)
+		return util.Succeed("邀请正在审核中，审核通过后发放奖励")
+	}
+
+	_, err = s.QuotaService.Change(ctx, invitee, consts.InviteeReward, ledger.ReasonInvitation, inviter)
 	if err != nil {
 		return nil, err
 	}
 	return util.Succeed("success")
 }
 
+// checkInvitation 返回邀请命中的所有风控规则
+func (s *UserService) checkInvitation(ctx context.Context, c *invitation.Code, l *invitation.Log) ([]string, error) {
+	conf := config.GetConfig().Invitation
+	now := s.Clock.Now()
+	risks := make([]string, 0)
+
+	// 与邀请者或其他被邀请者使用同一设备
+	if l.DeviceId != "" {
+		n, err := s.LogMapper.CountByDevice(ctx, l.DeviceId)
+		if err != nil {
+			return nil, err
+		}
+		if l.DeviceId == c.DeviceId || n > 0 {
+			risks = append(risks, invitation.RiskSameDevice)
+		}
+	}
+
+	// 与邀请者同一IP, 或同一IP下的被邀请者过多
+	if l.IP != "" {
+		n, err := s.LogMapper.CountByInviterIP(ctx, l.Inviter, l.IP)
+		if err != nil {
+			return nil, err
+		}
+		if l.IP == c.IP || n >= conf.IPLimit {
+			risks = append(risks, invitation.RiskSameIP)
+		}
+	}
+
+	// 账号注册时间和手机号
+	inviter, err := s.UserMapper.FindOne(ctx, l.Inviter)
+	if err != nil {
+		return nil, err
+	}
+	invitee, err := s.UserMapper.FindOne(ctx, l.Invitee)
+	if err != nil {
+		return nil, err
+	}
+	if now.Sub(inviter.CreateTime) < time.Duration(conf.MinInviterAge)*time.Hour {
+		risks = append(risks, invitation.RiskNewInviter)
+	}
+	if now.Sub(invitee.CreateTime) > time.Duration(conf.MaxInviteeAge)*time.Hour {
+		risks = append(risks, invitation.RiskOldInvitee)
+	}
+	if len(inviter.Phone) >= 7 && len(invitee.Phone) >= 7 && inviter.Phone[:7] == invitee.Phone[:7] {
+		risks = append(risks, invitation.RiskSimilarPhone)
+	}
+	for _, prefix := range invitation.VirtualPhonePrefixes {
+		if strings.HasPrefix(invitee.Phone, prefix) {
+			risks = append(risks, invitation.RiskVirtualPhone)
+			break
+		}
+	}
+
+	// 邀请者短时间内邀请人数过多
+	n, err := s.LogMapper.CountByInviterSince(ctx, l.Inviter, now.Add(-time.Duration(conf.VelocityPeriod)*time.Second))
+	if err != nil {
+		return nil, err
+	}
+	if n >= conf.VelocityLimit {
+		risks = append(risks, invitation.RiskVelocity)
+	}
+	return risks, nil
+}
+
+// ListInvitationReviews 管理员分页获取待审核的邀请, 按填写时间正序
+func (s *UserService) ListInvitationReviews(ctx context.Context, req *show.ListInvitationReviewsReq) (*show.ListInvitationReviewsResp, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	ls, total, err := s.LogMapper.FindManyByStatus(ctx, invitation.StatusReviewing, req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	dtos := make([]*show.InvitationReview, 0, len(ls))
+	for _, l := range ls {
+		dtos = append(dtos, &show.InvitationReview{
+			Id:         l.ID.Hex(),
+			Inviter:    l.Inviter,
+			Invitee:    l.Invitee,
+			Risks:      l.Risks,
+			DeviceId:   l.DeviceId,
+			Ip:         l.IP,
+			CreateTime: l.Timestamp.Unix(),
+		})
+	}
+	return &show.ListInvitationReviewsResp{
+		Code:    0,
+		Msg:     "success",
+		Reviews: dtos,
+		Total:   total,
+	}, nil
+}
+
+// ReviewInvitation 管理员审核邀请, 通过后按正常流程发放奖励, 拒绝后双方都不获得奖励
+func (s *UserService) ReviewInvitation(ctx context.Context, req *show.ReviewInvitationReq) (*show.Response, error) {
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	to := int64(invitation.StatusRejected)
+	if req.Approve {
+		to = invitation.StatusPending
+	}
+	l, err := s.LogMapper.Review(ctx, req.Id, adminId, req.Note, to)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Approve {
+		return util.Succeed("已拒绝")
+	}
+
+	// 发放被邀请者的奖励
+	if _, err = s.QuotaService.Change(ctx, l.Invitee, consts.InviteeReward, ledger.ReasonInvitation, l.Inviter); err != nil {
+		return nil, err
+	}
+
+	// 审核期间被邀请者已完成过批改时直接激活
+	n, err := s.EvaluateLogMapper.Count(ctx, l.Invitee)
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		if err = s.activateInvitation(ctx, &event.Event{Type: event.EssayEvaluated, UserId: l.Invitee}); err != nil {
+			return nil, err
+		}
+	}
+	return util.Succeed("已通过")
+}
+
+// activateInvitation 被邀请者完成首次批改时激活邀请记录, 奖励邀请者并发放达成的邀请里程碑奖励
+func (s *UserService) activateInvitation(ctx context.Context, e *event.Event) error {
+	l, err := s.LogMapper.Activate(ctx, e.UserId)
+	if errors.Is(err, consts.ErrNotFound) {
+		// 没有填写邀请码或已经激活过
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return s.rewardInviter(ctx, l)
+}
+
+// rewardInviter 向邀请者发放已激活邀请的奖励和达成的里程碑奖励, 全部发放后标记邀请记录已发放
+// 奖励按邀请记录和里程碑只生效一次, 中途失败时由定时任务重试, 不会漏发或重复发放
+func (s *UserService) rewardInviter(ctx context.Context, l *invitation.Log) error {
+	if _, err := s.QuotaService.ChangeOnce(ctx, l.Inviter, consts.InvitationReward, ledger.ReasonInvitation, l.ID.Hex()); err != nil {
+		return err
+	}
+
+	// 按已激活人数补齐所有达成的里程碑, 先发放再记录, 已记录的里程碑不再发放
+	n, err := s.LogMapper.CountActivated(ctx, l.Inviter)
+	if err != nil {
+		return err
+	}
+	c, err := s.CodeMapper.FindOneByUserId(ctx, l.Inviter)
+	if errors.Is(err, consts.ErrNotFound) {
+		// 邀请者已注销, 邀请码已删除
+		c = &invitation.Code{}
+	} else if err != nil {
+		return err
+	}
+	for _, m := range invitation.Milestones {
+		if m.Count > n {
+			break
+		}
+		if slices.Contains(c.Milestones, m.Count) {
+			continue
+		}
+		if _, err = s.QuotaService.ChangeOnce(ctx, l.Inviter, m.Reward, ledger.ReasonInvitation, "milestone_"+strconv.FormatInt(m.Count, 10)); err != nil {
+			return err
+		}
+		if _, err = s.CodeMapper.AddMilestone(ctx, l.Inviter, m.Count); err != nil {
+			return err
+		}
+	}
+
+	if err = s.LogMapper.MarkPaid(ctx, l.ID); err != nil {
+		return err
+	}
+	s.Bus.Publish(ctx, &event.Event{Type: event.InvitationAccepted, UserId: l.Inviter})
+	return nil
+}
+
+// SweepInvitations 为激活后长时间未发放完奖励的邀请补发奖励, 由定时任务调用
+func (s *UserService) SweepInvitations(ctx context.Context) error {
+	ls, err := s.LogMapper.FindUnpaid(ctx, s.Clock.Now().Add(-unpaidAfter))
+	if err != nil {
+		return err
+	}
+	for _, l := range ls {
+		// 单条记录失败不影响其他记录, 下次执行时继续重试
+		if err = s.rewardInviter(ctx, l); err != nil {
+			logx.CtxError(ctx, "invitation: reward %s for %s error %v", l.Inviter, l.ID.Hex(), err)
+		}
+	}
+	return nil
+}
+
 func (s *UserService) GetInvitationCode(ctx context.Context, req *show.GetInvitationCodeReq) (*show.GetInvitationCodeResp, error) {
 	// 用户信息
 	userMeta := adaptor.ExtractUserMeta(ctx)
@@ -403,10 +861,133 @@ func (s *UserService) GetInvitationCode(ctx context.Context, req *show.GetInvita
 		return nil, err
 	}
 
-	return &show.GetInvitationCodeResp{
+	// 记录邀请者使用的设备和IP, 用于识别自己邀请自己
+	deviceId, ip := userMeta.GetSessionDeviceId(), adaptor.ExtractExtra(ctx).GetClientIP()
+	if c.DeviceId != deviceId || c.IP != ip {
+		if err = s.CodeMapper.UpdateDevice(ctx, userMeta.GetUserId(), deviceId, ip); err != nil {
+			logx.CtxError(ctx, "invitation: update device of %s error %v", userMeta.GetUserId(), err)
+		}
+	}
+
+	resp := &show.GetInvitationCodeResp{
 		Code:           0,
 		Msg:            "success",
 		InvitationCode: c.Code,
+		Valid:          c.Valid(s.Clock.Now()),
+	}
+	if !c.ExpireTime.IsZero() {
+		resp.ExpireTime = c.ExpireTime.Unix()
+	}
+	return resp, nil
+}
+
+// UpdateInvitationCode 管理员为用户设置自定义邀请码、过期时间或停用邀请码
+func (s *UserService) UpdateInvitationCode(ctx context.Context, req *show.UpdateInvitationCodeReq) (*show.Response, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	c, err := s.CodeMapper.FindOneByUserId(ctx, req.UserId)
+	if errors.Is(err, consts.ErrNotFound) {
+		c, err = s.CodeMapper.Insert(ctx, req.UserId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if req.InvitationCode != nil {
+		code := strings.ToUpper(strings.TrimSpace(*req.InvitationCode))
+		if !customCodeRe.MatchString(code) {
+			return nil, consts.ErrInvalidParams
+		}
+		c.Code, c.Custom = code, true
+	}
+	c.ExpireTime = time.Time{}
+	if req.ExpireTime > 0 {
+		c.ExpireTime = time.Unix(req.ExpireTime, 0)
+	}
+	c.Deactivated = req.Deactivated
+	if err = s.CodeMapper.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return util.Succeed("success")
+}
+
+// SetUserRole 管理员设置用户的角色
+func (s *UserService) SetUserRole(ctx context.Context, req *show.SetUserRoleReq) (*show.Response, error) {
+	if _, err := checkAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if !util.Contains(user.Roles, req.Role) {
+		return nil, consts.ErrInvalidParams
+	}
+	if err := s.UserMapper.UpdateRole(ctx, req.UserId, req.Role); err != nil {
+		return nil, err
+	}
+	return util.Succeed("success")
+}
+
+// Role 查询用户的角色, 供路由组的访问策略使用
+func (s *UserService) Role(ctx context.Context, userId string) (string, error) {
+	u, err := s.UserMapper.FindOne(ctx, userId)
+	if err != nil {
+		return "", err
+	}
+	return roleOf(u), nil
+}
+
+// roleOf 返回用户的角色, 配置中的管理员始终视为管理员
+func roleOf(u *user.User) string {
+	switch {
+	case util.Contains(config.GetConfig().Admins, u.ID.Hex()):
+		return user.RoleAdmin
+	case u.Role == "":
+		return user.RoleStudent
+	default:
+		return u.Role
+	}
+}
+
+// ListInvitees 分页获取自己邀请的用户及其激活状态
+func (s *UserService) ListInvitees(ctx context.Context, req *show.ListInviteesReq) (*show.ListInviteesResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	ls, total, err := s.LogMapper.FindManyByInviter(ctx, meta.GetUserId(), req.PaginationOptions)
+	if err != nil {
+		return nil, err
+	}
+	activated, err := s.LogMapper.CountActivated(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	dtos := make([]*show.Invitee, 0, len(ls))
+	for _, l := range ls {
+		dto := &show.Invitee{
+			Activated:  l.Status == invitation.StatusActivated,
+			CreateTime: l.Timestamp.Unix(),
+			Status:     l.Status,
+		}
+		if !l.ActivateTime.IsZero() {
+			dto.ActivateTime = l.ActivateTime.Unix()
+		}
+		// 查询不到被邀请者时只展示邀请记录
+		if u, err := s.UserMapper.FindOne(ctx, l.Invitee); err == nil {
+			dto.Name = u.Username
+			dto.Avatar = u.Avatar
+		}
+		dtos = append(dtos, dto)
+	}
+	return &show.ListInviteesResp{
+		Code:      0,
+		Msg:       "success",
+		Invitees:  dtos,
+		Total:     total,
+		Activated: activated,
 	}, nil
 }
 
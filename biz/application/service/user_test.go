@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"testing"
+)
+
+func TestRoleOf(t *testing.T) {
+	admin := primitive.NewObjectID()
+	testutil.LoadConfig(t).Admins = []string{admin.Hex()}
+
+	cases := []struct {
+		name string
+		user *user.User
+		want string
+	}{
+		{"admin", &user.User{ID: primitive.NewObjectID(), Role: user.RoleAdmin}, user.RoleAdmin},
+		{"teacher", &user.User{ID: primitive.NewObjectID(), Role: user.RoleTeacher}, user.RoleTeacher},
+		{"parent", &user.User{ID: primitive.NewObjectID(), Role: user.RoleParent}, user.RoleParent},
+		{"student", &user.User{ID: primitive.NewObjectID(), Role: user.RoleStudent}, user.RoleStudent},
+		{"missing role", &user.User{ID: primitive.NewObjectID()}, user.RoleStudent},
+		// 未知角色原样返回, 由路由策略拒绝
+		{"unknown role", &user.User{ID: primitive.NewObjectID(), Role: "superuser"}, "superuser"},
+		{"configured admin without role", &user.User{ID: admin}, user.RoleAdmin},
+		{"configured admin with other role", &user.User{ID: admin, Role: user.RoleTeacher}, user.RoleAdmin},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := roleOf(c.user); got != c.want {
+				t.Errorf("roleOf(%+v) = %s, want %s", c.user, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/google/wire"
+	"github.com/xh-polaris/essay-show/biz/adaptor"
+	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/class"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/voucher"
+	logx "github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"strings"
+	"time"
+)
+
+type IVoucherService interface {
+	CreateVoucherBatch(ctx context.Context, req *show.CreateVoucherBatchReq) (*show.CreateVoucherBatchResp, error)
+	RedeemVoucher(ctx context.Context, req *show.RedeemVoucherReq) (*show.RedeemVoucherResp, error)
+}
+
+// VoucherService 管理学校采购的兑换码, 用户兑换后获得批改次数
+type VoucherService struct {
+	BatchMapper      *voucher.BatchMongoMapper
+	CodeMapper       *voucher.CodeMongoMapper
+	RedemptionMapper *voucher.RedemptionMongoMapper
+	Limiter          *voucher.RedeemLimiter
+	MemberMapper     *class.MemberMongoMapper
+	ClassMapper      *class.ClassMongoMapper
+	QuotaService     IQuotaService
+}
+
+var VoucherServiceSet = wire.NewSet(
+	wire.Struct(new(VoucherService), "*"),
+	wire.Bind(new(IVoucherService), new(*VoucherService)),
+)
+
+// CreateVoucherBatch 管理员创建一批兑换码
+func (s *VoucherService) CreateVoucherBatch(ctx context.Context, req *show.CreateVoucherBatchReq) (*show.CreateVoucherBatchResp, error) {
+	// 仅管理员可用
+	adminId, err := checkAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验参数
+	expire := time.Unix(req.ExpireTime, 0)
+	if req.Credits <= 0 || req.MaxUses <= 0 || req.Count <= 0 || req.Count > config.GetConfig().Voucher.MaxCount || !expire.After(time.Now()) {
+		return nil, consts.ErrInvalidParams
+	}
+
+	// 记录批次
+	b := &voucher.Batch{
+		Name:       req.Name,
+		Credits:    req.Credits,
+		Count:      req.Count,
+		MaxUses:    req.MaxUses,
+		School:     req.GetSchool(),
+		ExpireTime: expire,
+		CreatorId:  adminId,
+	}
+	if err = s.BatchMapper.Insert(ctx, b); err != nil {
+		return nil, err
+	}
+
+	// 生成兑换码
+	codes := make([]string, 0, req.Count)
+	for i := int64(0); i < req.Count; i++ {
+		c := &voucher.Code{
+			BatchId:    b.ID.Hex(),
+			Credits:    b.Credits,
+			MaxUses:    b.MaxUses,
+			School:     b.School,
+			ExpireTime: b.ExpireTime,
+		}
+		if err = s.CodeMapper.Insert(ctx, c); err != nil {
+			return nil, err
+		}
+		codes = append(codes, c.Code)
+	}
+
+	return &show.CreateVoucherBatchResp{
+		Code:    0,
+		Msg:     "success",
+		BatchId: b.ID.Hex(),
+		Codes:   codes,
+	}, nil
+}
+
+// RedeemVoucher 使用兑换码兑换批改次数
+func (s *VoucherService) RedeemVoucher(ctx context.Context, req *show.RedeemVoucherReq) (*show.RedeemVoucherResp, error) {
+	// 用户信息
+	meta := adaptor.ExtractUserMeta(ctx)
+	if meta.GetUserId() == "" {
+		return nil, consts.ErrNotAuthentication
+	}
+
+	code := strings.ToUpper(strings.TrimSpace(req.Code))
+	if code == "" {
+		return nil, consts.ErrInvalidParams
+	}
+
+	// 限制尝试次数
+	if err := s.Limiter.Allow(ctx, meta.GetUserId(), adaptor.ExtractExtra(ctx).GetClientIP()); err != nil {
+		return nil, err
+	}
+
+	// 补发之前已兑换但次数发放失败的兑换记录
+	resumed, balance, err := s.resume(ctx, meta.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 占用一次兑换机会, 后续校验失败时归还
+	c, err := s.CodeMapper.Take(ctx, code, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	release := func() {
+		if err := s.CodeMapper.Release(ctx, c); err != nil {
+			logx.CtxError(ctx, "voucher: release %s error %v", c.Code, err)
+		}
+	}
+	if c.School != "" {
+		ok, err := s.inSchool(ctx, meta.GetUserId(), c.School)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		if !ok {
+			release()
+			return nil, consts.ErrVoucherSchool
+		}
+	}
+	r := &voucher.Redemption{
+		CodeId:  c.ID.Hex(),
+		UserId:  meta.GetUserId(),
+		Credits: c.Credits,
+		Pending: true,
+	}
+	if err = s.RedemptionMapper.Insert(ctx, r); err != nil {
+		release()
+		// 重试之前发放失败的兑换, 次数已在上面补发
+		if errors.Is(err, consts.ErrRepeatRedeem) && resumed[c.ID.Hex()] {
+			return &show.RedeemVoucherResp{Code: 0, Msg: "兑换成功", Credits: c.Credits, Balance: balance}, nil
+		}
+		return nil, err
+	}
+
+	// 增加次数
+	if balance, err = s.grant(ctx, r); err != nil {
+		return nil, err
+	}
+
+	return &show.RedeemVoucherResp{
+		Code:    0,
+		Msg:     "兑换成功",
+		Credits: c.Credits,
+		Balance: balance,
+	}, nil
+}
+
+// grant 按兑换记录发放次数, 同一兑换记录只发放一次, 发放失败时保留为待发放, 用户重试时补发
+func (s *VoucherService) grant(ctx context.Context, r *voucher.Redemption) (int64, error) {
+	balance, err := s.QuotaService.ChangeOnce(ctx, r.UserId, r.Credits, ledger.ReasonVoucher, r.ID.Hex())
+	if err != nil {
+		return 0, err
+	}
+	if err = s.RedemptionMapper.MarkGranted(ctx, r.ID); err != nil {
+		logx.CtxError(ctx, "voucher: mark redemption %s granted error %v", r.ID.Hex(), err)
+	}
+	return balance, nil
+}
+
+// resume 补发用户待发放的兑换记录, 返回补发过的兑换码id和补发后的次数
+func (s *VoucherService) resume(ctx context.Context, userId string) (map[string]bool, int64, error) {
+	rs, err := s.RedemptionMapper.FindPending(ctx, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+	resumed := make(map[string]bool, len(rs))
+	var balance int64
+	for _, r := range rs {
+		if balance, err = s.grant(ctx, r); err != nil {
+			return nil, 0, err
+		}
+		resumed[r.CodeId] = true
+	}
+	return resumed, balance, nil
+}
+
+// inSchool 判断用户是否加入了该学校的班级, 学校以教师创建班级时填写的为准, 不使用用户自己填写的学校
+func (s *VoucherService) inSchool(ctx context.Context, userId, school string) (bool, error) {
+	mbs, err := s.MemberMapper.FindManyByUserId(ctx, userId)
+	if err != nil || len(mbs) == 0 {
+		return false, err
+	}
+	ids := make([]string, 0, len(mbs))
+	for _, mb := range mbs {
+		ids = append(ids, mb.ClassId)
+	}
+	cs, err := s.ClassMapper.FindManyByIds(ctx, ids)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range cs {
+		if c.School == school {
+			return true, nil
+		}
+	}
+	return false, nil
+}
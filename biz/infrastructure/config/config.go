@@ -23,18 +23,136 @@ type Coze struct {
 	BotId string
 }
 
+type Exercise struct {
+	FreeRegenerations int64 `json:",default=1"` // 每条批改记录可免费重新生成练习的次数
+}
+
+// StreakReward 连续签到达到Days天时额外奖励Reward次批改次数
+type StreakReward struct {
+	Days   int64
+	Reward int64
+}
+
+type Attend struct {
+	Reward                 int64          `json:",default=1"` // 每日签到奖励的批改次数
+	StreakRewards          []StreakReward `json:",optional"`  // 连续签到的里程碑奖励
+	MakeUpDays             int64          `json:",default=7"` // 可以补签最近几天内漏签的日期
+	InvitationMakeUpCards  int64          `json:",default=1"` // 每成功邀请一人获得的补签卡
+	AchievementMakeUpCards int64          `json:",default=1"` // 每获得一枚徽章获得的补签卡
+}
+
+type Voucher struct {
+	MaxCount    int64 `json:",default=1000"` // 一批最多生成的兑换码数量
+	UserLimit   int   `json:",default=10"`   // 每个用户每个周期内最多尝试兑换的次数
+	IPLimit     int   `json:",default=30"`   // 每个IP每个周期内最多尝试兑换的次数
+	LimitPeriod int   `json:",default=3600"` // 限制周期, 单位秒
+}
+
+// Invitation 邀请的风控阈值, 命中任意一条的邀请需要管理员审核
+type Invitation struct {
+	MinInviterAge  int64 `json:",default=24"`   // 邀请者注册不满多少小时
+	MaxInviteeAge  int64 `json:",default=72"`   // 被邀请者注册超过多少小时
+	IPLimit        int64 `json:",default=3"`    // 同一邀请者来自同一IP的被邀请者超过多少人
+	VelocityPeriod int64 `json:",default=3600"` // 统计邀请频率的周期, 单位秒
+	VelocityLimit  int64 `json:",default=5"`    // 每个周期内邀请超过多少人
+}
+
+// Plan 是一种可开通的套餐
+type Plan struct {
+	Id      string
+	Name    string
+	Type    string // unlimited期内不限次, monthly每月Credits次, expiring共Credits次且到期作废
+	Credits int64  `json:",optional"`
+	Days    int64  // 有效天数
+}
+
+// Product 是一种可购买的商品, 购买后获得Credits次批改次数或开通PlanId对应的套餐
+type Product struct {
+	Id      string
+	Name    string
+	Price   int64  // 价格, 单位分
+	Credits int64  `json:",optional"`
+	PlanId  string `json:",optional"`
+}
+
+// Payment 支付网关, 未配置时不开放订单接口, 配置时两项均必填, 避免漏配时回退到可伪造回调的模拟网关
+type Payment struct {
+	Gateway string // 支付网关
+	Secret  string // 回调验签的密钥
+}
+
+// Notification 站内通知及站外推送
+type Notification struct {
+	Channel      string `json:",default=noop"` // 站外推送渠道
+	StreakRemind int64  `json:",default=20"`   // 每天几点提醒连续签到即将中断的用户
+	StreakMin    int64  `json:",default=3"`    // 连续签到达到几天才提醒
+}
+
+// Account 账号注销
+type Account struct {
+	DeleteCooling int64 `json:",default=7"` // 申请注销后的冷静期, 单位天, 期间可撤销
+}
+
+// Relation 家长与学生账号绑定
+type Relation struct {
+	CodeExpire  int64 `json:",default=600"`  // 绑定码的有效期, 单位秒
+	MaxParents  int64 `json:",default=4"`    // 每个学生最多绑定的家长数
+	MaxStudents int64 `json:",default=5"`    // 每个家长最多绑定的学生数
+	MaxFailures int64 `json:",default=10"`   // 每个用户每个周期内最多填错绑定码的次数
+	FailPeriod  int64 `json:",default=3600"` // 填错次数的统计周期, 单位秒
+}
+
+// Class 班级与作业
+type Class struct {
+	MaxStudents int64 `json:",default=100"` // 每个班级最多的学生数
+	ScoreBucket int64 `json:",default=10"`  // 作业报告中分数分布的区间宽度
+	TopIssues   int64 `json:",default=5"`   // 作业报告中展示的常见问题数
+}
+
+// Analysis 批改前的本地文本分析
+type Analysis struct {
+	MinWords  int64    `json:",default=50"` // 字数少于该值的作文不予批改
+	RepeatMin int64    `json:",default=3"`  // 出现几次以上的短语视为重复
+	RepeatTop int64    `json:",default=10"` // 最多返回的重复短语数
+	Idioms    []string `json:",optional"`   // 内置词典之外的成语
+}
+
+// Moderation 提交作文的内容审核
+type Moderation struct {
+	Enabled     bool     `json:",default=true"`
+	Keywords    []string `json:",optional"` // 命中后拒绝批改的敏感词
+	KeywordFile string   `json:",optional"` // 敏感词文件, 每行一个, 与Keywords合并
+	SelfHarm    []string `json:",optional"` // 内置词表之外的自伤倾向短语
+	Bullying    []string `json:",optional"` // 内置词表之外的遭受霸凌的短语
+}
+
 type Config struct {
 	service.ServiceConf
 	ListenOn string
 	State    string
+	TimeZone string   `json:",default=Asia/Shanghai"` // 业务时区, 决定签到等按天统计的业务中一天的起止
+	Admins   []string `json:",optional"`              // 管理员的用户id
 	Auth     Auth
 	Mongo    struct {
 		URL string
 		DB  string
 	}
-	Cache cache.CacheConf
-	Redis *redis.RedisConf
-	Coze  *Coze
+	Cache      cache.CacheConf
+	Redis      *redis.RedisConf
+	Coze       *Coze
+	Exercise   Exercise
+	Attend     Attend
+	Invitation Invitation
+	Voucher    Voucher
+	Plans      []Plan    `json:",optional"`
+	Products   []Product `json:",optional"`
+	Payment    *Payment  `json:",optional"`
+	Notice     Notification
+	Account    Account
+	Relation   Relation
+	Class      Class
+	Analysis   Analysis
+	Moderation Moderation
 }
 
 func NewConfig() (*Config, error) {
@@ -9,10 +9,13 @@ const (
 	Status       = "status"
 	CreateTime   = "create_time"
 	DeleteStatus = 3
+	BanStatus    = 2
 	EffectStatus = 0
 	Phone        = "phone"
 	Timestamp    = "timestamp"
 	LogId        = "log_id"
+	ExerciseId   = "exercise_id"
+	QuestionId   = "question_id"
 	NotEqual     = "$ne"
 )
 
@@ -37,6 +40,20 @@ const (
 	AppId            = 14
 	Like             = 1
 	DisLike          = -1
-	InvitationReward = 10
-	AttendReward     = 1
+	InvitationReward = 10 // 被邀请者完成首次批改后邀请者获得的次数
+	InviteeReward    = 5  // 填写邀请码后被邀请者获得的次数
+)
+
+// 题目举报
+const (
+	ReportWrongAnswer = 1 // 答案错误
+	ReportAmbiguous   = 2 // 题意模糊
+	ReportOffTopic    = 3 // 偏离主题
+	ReportOther       = 4 // 其他
+)
+
+// 排行榜
+const (
+	DefaultRankTop = 10
+	MaxRankTop     = 100
 )
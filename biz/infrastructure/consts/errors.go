@@ -48,6 +48,41 @@ var (
 	ErrGetInvitation     = NewErrno(codes.Code(1013), errors.New("获取邀请码失败，请重试"))
 	ErrExerciseTimeout   = NewErrno(codes.Code(1014), errors.New("生成练习超时"))
 	ErrExercise          = NewErrno(codes.Code(1015), errors.New("生成练习失败"))
+	ErrReport            = NewErrno(codes.Code(1016), errors.New("举报失败，请重试"))
+	ErrRepeatReport      = NewErrno(codes.Code(1017), errors.New("已举报过该题目"))
+	ErrNoMakeUpCard      = NewErrno(codes.Code(1018), errors.New("补签卡不足"))
+	ErrMakeUp            = NewErrno(codes.Code(1019), errors.New("该日期无法补签"))
+	ErrVoucher           = NewErrno(codes.Code(1020), errors.New("兑换码无效、已过期或已用完"))
+	ErrRepeatRedeem      = NewErrno(codes.Code(1021), errors.New("已兑换过该兑换码"))
+	ErrVoucherSchool     = NewErrno(codes.Code(1022), errors.New("该兑换码仅限指定学校班级的学生使用"))
+	ErrVoucherCode       = NewErrno(codes.Code(1023), errors.New("生成兑换码失败，请重试"))
+	ErrTooManyAttempts   = NewErrno(codes.Code(1024), errors.New("尝试次数过多，请稍后再试"))
+	ErrPlanNotFound      = NewErrno(codes.Code(1025), errors.New("套餐不存在"))
+	ErrProductNotFound   = NewErrno(codes.Code(1026), errors.New("商品不存在"))
+	ErrOrderStatus       = NewErrno(codes.Code(1027), errors.New("订单状态不允许该操作"))
+	ErrPayment           = NewErrno(codes.Code(1028), errors.New("支付失败，请重试"))
+	ErrRefund            = NewErrno(codes.Code(1029), errors.New("退款失败，请重试"))
+	ErrInvitationState   = NewErrno(codes.Code(1030), errors.New("该邀请不在待审核状态"))
+	ErrInvitationTaken   = NewErrno(codes.Code(1031), errors.New("该邀请码已被占用"))
+	ErrInvitationExpired = NewErrno(codes.Code(1032), errors.New("邀请码已失效"))
+	ErrUserBanned        = NewErrno(codes.Code(1033), errors.New("账号已被封禁"))
+	ErrUserDeleted       = NewErrno(codes.Code(1034), errors.New("账号已注销"))
+	ErrLinkCode          = NewErrno(codes.Code(1035), errors.New("绑定码无效或已过期"))
+	ErrRelationExists    = NewErrno(codes.Code(1036), errors.New("已绑定该学生"))
+	ErrRelationLimit     = NewErrno(codes.Code(1037), errors.New("绑定数量已达上限"))
+	ErrClassCode         = NewErrno(codes.Code(1038), errors.New("班级码无效"))
+	ErrClassJoined       = NewErrno(codes.Code(1039), errors.New("已加入该班级"))
+	ErrClassFull         = NewErrno(codes.Code(1040), errors.New("班级人数已满"))
+	ErrNotClassMember    = NewErrno(codes.Code(1041), errors.New("未加入该作业所在的班级"))
+	ErrAssignmentClosed  = NewErrno(codes.Code(1042), errors.New("作业已截止"))
+	ErrCreateClass       = NewErrno(codes.Code(1043), errors.New("创建班级失败，请重试"))
+	ErrReviewConflict    = NewErrno(codes.Code(1044), errors.New("批阅已被更新，请刷新后重试"))
+	ErrEssayEmpty        = NewErrno(codes.Code(1045), errors.New("作文内容为空"))
+	ErrEssayTooShort     = NewErrno(codes.Code(1046), errors.New("作文字数过少，无法批改"))
+	ErrContentRejected   = NewErrno(codes.Code(1047), errors.New("作文包含不适宜的内容，无法批改"))
+	ErrNotEssay          = NewErrno(codes.Code(1048), errors.New("提交的内容不像是一篇作文，请检查后重新提交"))
+	ErrEntitlementExists = NewErrno(codes.Code(1049), errors.New("该来源的权益已开通"))
+	ErrRefundConsumed    = NewErrno(codes.Code(1050), errors.New("订单发放的次数已被使用，无法退款"))
 )
 
 // ErrInvalidParams 调用时错误
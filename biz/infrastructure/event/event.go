@@ -0,0 +1,70 @@
+package event
+
+import (
+	"context"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"github.com/zeromicro/go-zero/core/threading"
+	"sync"
+	"time"
+)
+
+// 领域事件类型
+const (
+	EssayEvaluated     = "essay_evaluated"     // 完成一次作文批改, Value为总分, 无法解析时为-1
+	ExerciseSubmitted  = "exercise_submitted"  // 首次提交一套练习, Value为答对的题数
+	DailyAttended      = "daily_attended"      // 完成一次签到
+	InvitationAccepted = "invitation_accepted" // 被邀请者完成首次批改, UserId为邀请者
+	AchievementEarned  = "achievement_earned"  // 获得一枚徽章
+)
+
+// Event 是业务中发生的领域事件
+type Event struct {
+	Type   string
+	UserId string
+	Value  int64
+	Time   time.Time
+}
+
+type Handler func(ctx context.Context, e *Event) error
+
+// Bus 进程内的事件总线, 事件在独立的协程中分发, 不影响发布方的请求
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe 订阅一种或多种事件
+func (b *Bus) Subscribe(h Handler, types ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, t := range types {
+		b.handlers[t] = append(b.handlers[t], h)
+	}
+}
+
+// Publish 发布事件, 处理失败只记录日志
+func (b *Bus) Publish(ctx context.Context, e *Event) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	b.mu.RLock()
+	hs := b.handlers[e.Type]
+	b.mu.RUnlock()
+	if len(hs) == 0 {
+		return
+	}
+
+	// 请求结束后ctx会被取消, 分发时只保留其中的值
+	ctx = context.WithoutCancel(ctx)
+	threading.GoSafe(func() {
+		for _, h := range hs {
+			if err := h(ctx, e); err != nil {
+				log.CtxError(ctx, "event: handle %s for %s error %v", e.Type, e.UserId, err)
+			}
+		}
+	})
+}
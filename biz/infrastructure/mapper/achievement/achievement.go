@@ -0,0 +1,53 @@
+package achievement
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type (
+	// Achievement 是用户获得的一枚徽章, 同一用户的同一徽章只会有一条记录
+	Achievement struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		UserId     string             `bson:"user_id" json:"userId"`
+		Badge      string             `bson:"badge" json:"badge"` // 徽章标识
+		CreateTime time.Time          `bson:"create_time" json:"createTime"`
+	}
+
+	// Progress 是用户在各项统计指标上的累计值, 每个用户一条记录
+	Progress struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		UserId     string             `bson:"user_id" json:"userId"`
+		Metrics    map[string]int64   `bson:"metrics" json:"metrics"`
+		UpdateTime time.Time          `bson:"update_time" json:"updateTime"`
+	}
+)
+
+// 统计指标
+const (
+	MetricEvaluateCount    = "evaluate_count"    // 累计批改次数
+	MetricLastScore        = "last_score"        // 最近一次批改的总分
+	MetricScoreImprovement = "score_improvement" // 相邻两次批改的最大提分
+	MetricAttendStreak     = "attend_streak"     // 最长连续签到天数
+	MetricExerciseCorrect  = "exercise_correct"  // 累计答对的练习题数
+	MetricInvitationCount  = "invitation_count"  // 累计成功邀请人数
+)
+
+// Badge 是一条徽章规则, 指标达到Target时获得, Reward大于0时额外奖励批改次数
+type Badge struct {
+	Code        string
+	Name        string
+	Description string
+	Metric      string
+	Target      int64
+	Reward      int64
+}
+
+// Badges 所有徽章规则, 按展示顺序排列
+var Badges = []*Badge{
+	{Code: "first_evaluate", Name: "初试锋芒", Description: "完成第一次作文批改", Metric: MetricEvaluateCount, Target: 1, Reward: 1},
+	{Code: "attend_streak_7", Name: "持之以恒", Description: "连续签到7天", Metric: MetricAttendStreak, Target: 7, Reward: 2},
+	{Code: "exercise_correct_100", Name: "百题斩", Description: "累计答对100道练习题", Metric: MetricExerciseCorrect, Target: 100, Reward: 3},
+	{Code: "invitation_5", Name: "呼朋唤友", Description: "成功邀请5位好友", Metric: MetricInvitationCount, Target: 5, Reward: 5},
+	{Code: "score_improvement_5", Name: "更上一层楼", Description: "作文总分比上一次提高5分以上", Metric: MetricScoreImprovement, Target: 5},
+}
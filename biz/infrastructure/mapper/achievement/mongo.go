@@ -0,0 +1,66 @@
+package achievement
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "achievement"
+	badge          = "badge"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, a *Achievement) (bool, error)
+	FindByUserId(ctx context.Context, userId string) ([]*Achievement, error)
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	// 同一用户的同一徽章只能获得一次
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: consts.UserID, Value: 1}, {Key: badge, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+// Insert 插入一条获得记录, 已获得过该徽章时返回false
+func (m *MongoMapper) Insert(ctx context.Context, a *Achievement) (bool, error) {
+	if a.ID.IsZero() {
+		a.ID = primitive.NewObjectID()
+		a.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, a)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (m *MongoMapper) FindByUserId(ctx context.Context, userId string) ([]*Achievement, error) {
+	as := make([]*Achievement, 0)
+	err := m.conn.Find(ctx, &as, bson.M{consts.UserID: userId})
+	return as, err
+}
+
+// DeleteByUserId 删除用户获得的所有徽章
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
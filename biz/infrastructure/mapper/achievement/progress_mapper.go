@@ -0,0 +1,91 @@
+package achievement
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+)
+
+const (
+	progressCollectionName = "achievement_progress"
+	metrics                = "metrics."
+	updateTime             = "update_time"
+)
+
+type IProgressMongoMapper interface {
+	Incr(ctx context.Context, userId, metric string, delta int64) (*Progress, error)
+	Max(ctx context.Context, userId, metric string, value int64) (*Progress, error)
+	Swap(ctx context.Context, userId, metric string, value int64) (*Progress, error)
+	FindOneByUserId(ctx context.Context, userId string) (*Progress, error)
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type ProgressMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewProgressMongoMapper(config *config.Config) *ProgressMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, progressCollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: consts.UserID, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &ProgressMongoMapper{conn: conn}
+}
+
+// Incr 增加指标的值, 返回更新后的进度
+func (m *ProgressMongoMapper) Incr(ctx context.Context, userId, metric string, delta int64) (*Progress, error) {
+	return m.upsert(ctx, userId, bson.M{"$inc": bson.M{metrics + metric: delta}}, options.After)
+}
+
+// Max 仅当新值更大时更新指标, 返回更新后的进度
+func (m *ProgressMongoMapper) Max(ctx context.Context, userId, metric string, value int64) (*Progress, error) {
+	return m.upsert(ctx, userId, bson.M{"$max": bson.M{metrics + metric: value}}, options.After)
+}
+
+// Swap 设置指标的值, 返回更新前的进度, 首次记录时返回consts.ErrNotFound
+func (m *ProgressMongoMapper) Swap(ctx context.Context, userId, metric string, value int64) (*Progress, error) {
+	return m.upsert(ctx, userId, bson.M{"$set": bson.M{metrics + metric: value}}, options.Before)
+}
+
+func (m *ProgressMongoMapper) FindOneByUserId(ctx context.Context, userId string) (*Progress, error) {
+	p := &Progress{}
+	err := m.conn.FindOneNoCache(ctx, p, bson.M{consts.UserID: userId})
+	switch {
+	case err == nil:
+		return p, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+func (m *ProgressMongoMapper) upsert(ctx context.Context, userId string, update bson.M, rd options.ReturnDocument) (*Progress, error) {
+	update["$currentDate"] = bson.M{updateTime: true}
+	p := &Progress{}
+	err := m.conn.FindOneAndUpdateNoCache(ctx, p, bson.M{consts.UserID: userId}, update,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(rd))
+	switch {
+	case err == nil:
+		return p, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// DeleteByUserId 删除用户的徽章进度
+func (m *ProgressMongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
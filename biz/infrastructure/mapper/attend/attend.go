@@ -2,12 +2,86 @@ package attend
 
 import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sort"
 	"time"
 )
 
+// DateLayout 签到日期的格式
+const DateLayout = "2006-01-02"
+
 // Attend 记录用户每日的签到情况
 type Attend struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"` // uid
-	UserId    string             `bson:"user_id"`       // 记录的用户Id
-	Timestamp time.Time          `bson:"timestamp"`     // 签到的时间
+	ID         primitive.ObjectID `bson:"_id,omitempty"`        // uid
+	UserId     string             `bson:"user_id"`              // 记录的用户Id
+	Date       string             `bson:"date,omitempty"`       // 业务时区下的签到日期, 与user_id构成唯一索引
+	Timestamp  time.Time          `bson:"timestamp"`            // 签到的时间
+	Streak     int64              `bson:"streak"`               // 截至当天的连续签到天数
+	MakeUp     bool               `bson:"make_up"`              // 是否为使用补签卡补签
+	Milestones []int64            `bson:"milestones,omitempty"` // 在这条记录上发放过的连续签到里程碑, 即达到的天数
+}
+
+// Day 返回t在loc时区下所在自然日的零点
+func Day(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// DateKey 返回t在loc时区下的签到日期
+func DateKey(t time.Time, loc *time.Location) string {
+	return t.In(loc).Format(DateLayout)
+}
+
+// Day 返回签到日期在loc时区下的零点, 早期没有Date的记录按签到时间计算
+func (a *Attend) Day(loc *time.Location) time.Time {
+	if a.Date != "" {
+		if d, err := time.ParseInLocation(DateLayout, a.Date, loc); err == nil {
+			return d
+		}
+	}
+	return Day(a.Timestamp, loc)
+}
+
+// Next 根据最近一条签到记录计算在now签到后的连续签到天数, 日期按now所在时区划分, 当天已签到时返回false
+func Next(last *Attend, now time.Time) (int64, bool) {
+	if last == nil || last.Timestamp.IsZero() {
+		return 1, true
+	}
+	today, day := Day(now, now.Location()), last.Day(now.Location())
+	switch {
+	case day.Equal(today):
+		return 0, false
+	case day.Equal(today.AddDate(0, 0, -1)):
+		return max(last.Streak, 1) + 1, true
+	default:
+		return 1, true
+	}
+}
+
+// LongestStreak 计算一组签到记录中最长的连续签到天数
+func LongestStreak(as []*Attend, loc *time.Location) int64 {
+	days := make(map[time.Time]bool, len(as))
+	for _, a := range as {
+		if a.Timestamp.IsZero() {
+			continue
+		}
+		days[a.Day(loc)] = true
+	}
+	sorted := make([]time.Time, 0, len(days))
+	for d := range days {
+		sorted = append(sorted, d)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	var longest, cur int64
+	for i, d := range sorted {
+		if i > 0 && sorted[i-1].AddDate(0, 0, 1).Equal(d) {
+			cur++
+		} else {
+			cur = 1
+		}
+		if cur > longest {
+			longest = cur
+		}
+	}
+	return longest
 }
@@ -0,0 +1,113 @@
+package attend
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
+	"testing"
+	"time"
+)
+
+var shanghai = mustLoad("Asia/Shanghai")
+
+func mustLoad(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
+
+// 业务时区为东八区, 北京时间零点是UTC前一天的16点, UTC零点是北京时间8点
+func TestDateKey(t *testing.T) {
+	cases := []struct {
+		name string
+		now  time.Time
+		loc  *time.Location
+		want string
+	}{
+		{"business 23:59", time.Date(2026, 3, 1, 23, 59, 59, 0, shanghai), shanghai, "2026-03-01"},
+		{"business 00:00", time.Date(2026, 3, 2, 0, 0, 0, 0, shanghai), shanghai, "2026-03-02"},
+		{"business 00:00 seen in utc", time.Date(2026, 3, 2, 0, 0, 0, 0, shanghai), time.UTC, "2026-03-01"},
+		{"utc 23:59 seen in business", time.Date(2026, 3, 1, 23, 59, 59, 0, time.UTC), shanghai, "2026-03-02"},
+		{"utc 00:00 seen in business", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), shanghai, "2026-03-02"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			now := clock.Fixed(c.now).Now()
+			if got := DateKey(now, c.loc); got != c.want {
+				t.Errorf("DateKey(%v, %v) = %s, want %s", now, c.loc, got, c.want)
+			}
+			if got := Day(now, c.loc).Format(DateLayout); got != c.want {
+				t.Errorf("Day(%v, %v) = %s, want %s", now, c.loc, got, c.want)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 北京时间3月1日23:59签到, 当时已连续签到3天
+	last := &Attend{
+		Date:      "2026-03-01",
+		Timestamp: time.Date(2026, 3, 1, 23, 59, 0, 0, shanghai),
+		Streak:    3,
+	}
+	// 早期没有Date的记录, 按签到时间在业务时区下的日期计算
+	legacy := &Attend{
+		Timestamp: time.Date(2026, 3, 1, 15, 59, 0, 0, time.UTC), // 北京时间3月1日23:59
+		Streak:    3,
+	}
+	cases := []struct {
+		name   string
+		last   *Attend
+		now    time.Time
+		streak int64
+		ok     bool
+	}{
+		{"first attend", nil, time.Date(2026, 3, 1, 12, 0, 0, 0, shanghai), 1, true},
+		{"zero record", &Attend{}, time.Date(2026, 3, 1, 12, 0, 0, 0, shanghai), 1, true},
+		{"same business day at 23:59", last, time.Date(2026, 3, 1, 23, 59, 59, 0, shanghai), 0, false},
+		{"next business day at 00:00", last, time.Date(2026, 3, 2, 0, 0, 0, 0, shanghai), 4, true},
+		{"next business day at 23:59", last, time.Date(2026, 3, 2, 23, 59, 59, 0, shanghai), 4, true},
+		{"missed a business day", last, time.Date(2026, 3, 3, 0, 0, 0, 0, shanghai), 1, true},
+		// UTC仍是3月1日, 但北京时间已是3月2日
+		{"utc same day is the next business day", last, time.Date(2026, 3, 1, 16, 0, 0, 0, time.UTC).In(shanghai), 4, true},
+		{"legacy same business day", legacy, time.Date(2026, 3, 1, 23, 59, 59, 0, shanghai), 0, false},
+		{"legacy next business day", legacy, time.Date(2026, 3, 2, 0, 0, 0, 0, shanghai), 4, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			now := clock.Fixed(c.now.In(shanghai)).Now()
+			streak, ok := Next(c.last, now)
+			if streak != c.streak || ok != c.ok {
+				t.Errorf("Next at %v = (%d, %v), want (%d, %v)", now, streak, ok, c.streak, c.ok)
+			}
+		})
+	}
+}
+
+// 在UTC下判断会把北京时间同一天的两次签到算作相邻两天的连续签到
+func TestNextDependsOnBusinessTimezone(t *testing.T) {
+	last := &Attend{Timestamp: time.Date(2026, 3, 1, 7, 0, 0, 0, shanghai), Streak: 1} // UTC为2月28日23:00
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, shanghai)                                 // UTC为3月1日01:00
+
+	if _, ok := Next(last, clock.Fixed(now).Now()); ok {
+		t.Errorf("attend twice on the same business day should be rejected")
+	}
+	if streak, ok := Next(last, clock.Fixed(now.In(time.UTC)).Now()); !ok || streak != 2 {
+		t.Errorf("in utc the two attends fall on consecutive days, got (%d, %v)", streak, ok)
+	}
+}
+
+func TestLongestStreak(t *testing.T) {
+	// 北京时间连续三天在零点前后签到, UTC下前两次签到在同一天, 第三次与之隔了一天
+	as := []*Attend{
+		{Timestamp: time.Date(2026, 3, 1, 23, 59, 0, 0, shanghai)},
+		{Timestamp: time.Date(2026, 3, 2, 0, 0, 30, 0, shanghai)},
+		{Timestamp: time.Date(2026, 3, 3, 23, 59, 0, 0, shanghai)},
+	}
+	if got := LongestStreak(as, shanghai); got != 3 {
+		t.Errorf("LongestStreak in business timezone = %d, want 3", got)
+	}
+	if got := LongestStreak(as, time.UTC); got != 1 {
+		t.Errorf("LongestStreak in utc = %d, want 1", got)
+	}
+}
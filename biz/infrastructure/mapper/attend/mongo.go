@@ -16,6 +16,9 @@ import (
 const (
 	prefixKeyCacheKey = "cache:attend"
 	CollectionName    = "attend"
+	date              = "date"
+	streak            = "streak"
+	milestones        = "milestones"
 )
 
 type IMongoMapper interface {
@@ -23,7 +26,14 @@ type IMongoMapper interface {
 	InsertZeroOne(ctx context.Context, userId string) (*Attend, error)
 	FindLatestOneByUserId(ctx context.Context, userId string) (a *Attend, err error)
 	Update(ctx context.Context, a *Attend) error
-	FindByYearAndMonth(ctx context.Context, userId string, year int, month int) (as []*Attend, total int64, err error)
+	UpdateStreak(ctx context.Context, id primitive.ObjectID, n int64) error
+	AddMilestone(ctx context.Context, id primitive.ObjectID, days int64) (bool, error)
+	FindByYearAndMonth(ctx context.Context, userId string, year int, month int, loc *time.Location) (as []*Attend, total int64, err error)
+	FindBetween(ctx context.Context, userId string, start, end time.Time) (as []*Attend, err error)
+	LongestStreaks(ctx context.Context, start, end time.Time, loc *time.Location) (map[string]int64, error)
+	FindManyByDate(ctx context.Context, d string, minStreak int64) (as []*Attend, err error)
+	FindAllByUserId(ctx context.Context, userId string) (as []*Attend, err error)
+	DeleteByUserId(ctx context.Context, userId string) error
 }
 
 type MongoMapper struct {
@@ -32,6 +42,22 @@ type MongoMapper struct {
 
 func NewMongoMapper(config *config.Config) *MongoMapper {
 	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	// 同一用户一天只能有一条签到记录, 早期没有date的记录不参与
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.UserID, Value: 1}, {Key: date, Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{date: bson.M{"$type": "string"}}),
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 重建排行榜时按签到时间范围汇总所有用户的记录
+	_, err = conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.Timestamp, Value: 1}},
+	})
+	if err != nil {
+		panic(err)
+	}
 	return &MongoMapper{conn: conn}
 }
 
@@ -45,8 +71,12 @@ func (m *MongoMapper) InsertZeroOne(ctx context.Context, userId string) (*Attend
 	return a, err
 }
 
+// Insert 插入一条签到记录, 当天已有记录时返回consts.ErrRepeatDailyAttend
 func (m *MongoMapper) Insert(ctx context.Context, a *Attend) error {
 	_, err := m.conn.InsertOneNoCache(ctx, a)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrRepeatDailyAttend
+	}
 	return err
 }
 
@@ -70,10 +100,26 @@ func (m *MongoMapper) Update(ctx context.Context, a *Attend) error {
 	return err
 }
 
-func (m *MongoMapper) FindByYearAndMonth(ctx context.Context, userId string, year int, month int) (as []*Attend, total int64, err error) {
+// UpdateStreak 只修改连续签到天数, 不覆盖记录上已发放的里程碑
+func (m *MongoMapper) UpdateStreak(ctx context.Context, id primitive.ObjectID, n int64) error {
+	_, err := m.conn.UpdateByIDNoCache(ctx, id, bson.M{"$set": bson.M{streak: n}})
+	return err
+}
+
+// AddMilestone 在签到记录上记录已发放的连续签到里程碑, 已记录过时返回false, 保证每个里程碑只奖励一次
+func (m *MongoMapper) AddMilestone(ctx context.Context, id primitive.ObjectID, days int64) (bool, error) {
+	res, err := m.conn.UpdateOneNoCache(ctx, bson.M{consts.ID: id, milestones: bson.M{consts.NotEqual: days}},
+		bson.M{"$push": bson.M{milestones: days}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (m *MongoMapper) FindByYearAndMonth(ctx context.Context, userId string, year int, month int, loc *time.Location) (as []*Attend, total int64, err error) {
 	as = make([]*Attend, 0)
-	// 构造这个月的开始和结束
-	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.Local)
+	// 构造这个月在业务时区下的开始和结束
+	start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
 	end := start.AddDate(0, 1, 0).Add(-time.Nanosecond)
 	// 找到这个月所有的签到记录
 	err = m.conn.Find(ctx, &as, bson.M{
@@ -94,3 +140,94 @@ func (m *MongoMapper) FindByYearAndMonth(ctx context.Context, userId string, yea
 	}
 	return as, total, nil
 }
+
+// FindBetween 获取[start, end)内的签到记录, userId为空时获取所有用户的记录
+func (m *MongoMapper) FindBetween(ctx context.Context, userId string, start, end time.Time) (as []*Attend, err error) {
+	as = make([]*Attend, 0)
+	filter := bson.M{
+		consts.Timestamp: bson.M{
+			"$gte": start,
+			"$lt":  end,
+		},
+	}
+	if userId != "" {
+		filter[consts.UserID] = userId
+	}
+	err = m.conn.Find(ctx, &as, filter, options.Find().SetSort(bson.M{consts.Timestamp: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+// LongestStreaks 按用户汇总[start, end)内最长的连续签到天数, 与LongestStreak的计算方式一致
+func (m *MongoMapper) LongestStreaks(ctx context.Context, start, end time.Time, loc *time.Location) (map[string]int64, error) {
+	var data []struct {
+		UserId  string `bson:"_id"`
+		Longest int64  `bson:"longest"`
+	}
+	// 签到日期按UTC零点解析后换算成天数, 相邻两天恰好相差1, 不受夏令时影响
+	day := bson.M{"$divide": bson.A{
+		bson.M{"$toLong": bson.M{"$dateFromString": bson.M{
+			"dateString": bson.M{"$ifNull": bson.A{"$" + date, bson.M{"$dateToString": bson.M{
+				"format": "%Y-%m-%d", "date": "$" + consts.Timestamp, "timezone": loc.String(),
+			}}}},
+			"format":   "%Y-%m-%d",
+			"timezone": "UTC",
+		}}},
+		int64(24 * time.Hour / time.Millisecond),
+	}}
+	err := m.conn.Aggregate(ctx, &data, []bson.M{
+		{"$match": bson.M{consts.Timestamp: bson.M{"$gte": start, "$lt": end}}},
+		{"$group": bson.M{consts.ID: bson.M{"user": "$" + consts.UserID, "day": day}}},
+		{"$sort": bson.D{{Key: "_id.user", Value: 1}, {Key: "_id.day", Value: 1}}},
+		{"$group": bson.M{consts.ID: "$_id.user", "days": bson.M{"$push": "$_id.day"}}},
+		{"$project": bson.M{"longest": bson.M{"$reduce": bson.M{
+			"input":        "$days",
+			"initialValue": bson.M{"prev": nil, "cur": 0, "max": 0},
+			"in": bson.M{"$let": bson.M{
+				"vars": bson.M{"cur": bson.M{"$cond": bson.A{
+					bson.M{"$eq": bson.A{"$$value.prev", bson.M{"$subtract": bson.A{"$$this", 1}}}},
+					bson.M{"$add": bson.A{"$$value.cur", 1}},
+					1,
+				}}},
+				"in": bson.M{"prev": "$$this", "cur": "$$cur", "max": bson.M{"$max": bson.A{"$$value.max", "$$cur"}}},
+			}},
+		}}}},
+		{"$project": bson.M{"longest": "$longest.max"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	streaks := make(map[string]int64, len(data))
+	for _, d := range data {
+		streaks[d.UserId] = d.Longest
+	}
+	return streaks, nil
+}
+
+// FindManyByDate 获取某天连续签到不少于minStreak天的签到记录
+func (m *MongoMapper) FindManyByDate(ctx context.Context, d string, minStreak int64) (as []*Attend, err error) {
+	as = make([]*Attend, 0)
+	err = m.conn.Find(ctx, &as, bson.M{date: d, "streak": bson.M{"$gte": minStreak}})
+	if err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+// FindAllByUserId 获取用户所有的签到记录, 用于导出个人数据
+func (m *MongoMapper) FindAllByUserId(ctx context.Context, userId string) (as []*Attend, err error) {
+	as = make([]*Attend, 0)
+	err = m.conn.Find(ctx, &as, bson.M{consts.UserID: userId}, options.Find().SetSort(bson.M{consts.Timestamp: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+// DeleteByUserId 删除用户所有的签到记录
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
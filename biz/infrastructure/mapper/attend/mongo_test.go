@@ -0,0 +1,52 @@
+package attend
+
+import (
+	"context"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+	"testing"
+	"time"
+)
+
+// 数据库中汇总的最长连续签到天数与LongestStreak一致, 早期没有date的记录按签到时间计算
+func TestLongestStreaks(t *testing.T) {
+	m := NewMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	day := func(userId string, d int, legacy bool) *Attend {
+		ts := time.Date(2026, 3, d, 23, 30, 0, 0, shanghai)
+		a := &Attend{UserId: userId, Timestamp: ts, Streak: 1}
+		if !legacy {
+			a.Date = DateKey(ts, shanghai)
+		}
+		return a
+	}
+	as := map[string][]*Attend{
+		"a": {day("a", 1, false), day("a", 2, true), day("a", 3, false), day("a", 5, false)},
+		"b": {day("b", 10, false)},
+		// 月末之后的记录不在范围内
+		"c": {day("c", 30, false), day("c", 31, false)},
+	}
+	for _, v := range as {
+		for _, a := range v {
+			if err := m.Insert(ctx, a); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, shanghai)
+	end := time.Date(2026, 3, 31, 0, 0, 0, 0, shanghai)
+	got, err := m.LongestStreaks(ctx, start, end, shanghai)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int64{"a": 3, "b": 1, "c": 1}
+	for userId, n := range want {
+		if got[userId] != n {
+			t.Errorf("streak of %s = %d, want %d", userId, got[userId], n)
+		}
+	}
+	if n := LongestStreak(as["a"], shanghai); n != want["a"] {
+		t.Errorf("LongestStreak = %d, want %d", n, want["a"])
+	}
+}
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// Log 是一次管理员操作的审计记录, 只追加不修改
+type Log struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	AdminId    string             `bson:"admin_id" json:"adminId"`
+	Action     string             `bson:"action" json:"action"`   // 操作的接口路径
+	Request    string             `bson:"request" json:"request"` // 请求参数
+	Status     int                `bson:"status" json:"status"`   // 响应的http状态码
+	CreateTime time.Time          `bson:"create_time" json:"createTime"`
+}
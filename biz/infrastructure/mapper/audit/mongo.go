@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "admin_audit"
+	adminId        = "admin_id"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, l *Log) error
+	FindMany(ctx context.Context, admin string, p *basic.PaginationOptions) (ls []*Log, total int64, err error)
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	return &MongoMapper{conn: conn}
+}
+
+func (m *MongoMapper) Insert(ctx context.Context, l *Log) error {
+	if l.ID.IsZero() {
+		l.ID = primitive.NewObjectID()
+		l.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, l)
+	return err
+}
+
+// FindMany 分页获取审计记录, 按时间倒序, admin为空时获取所有管理员的记录
+func (m *MongoMapper) FindMany(ctx context.Context, admin string, p *basic.PaginationOptions) (ls []*Log, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	filter := bson.M{}
+	if admin != "" {
+		filter[adminId] = admin
+	}
+	ls = make([]*Log, 0, limit)
+	err = m.conn.Find(ctx, &ls, filter, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}, {Key: consts.ID, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ls, total, nil
+}
@@ -0,0 +1,91 @@
+package class
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const assignmentCollectionName = "class_assignment"
+
+type IAssignmentMongoMapper interface {
+	Insert(ctx context.Context, a *Assignment) error
+	FindOne(ctx context.Context, id string) (*Assignment, error)
+	FindManyByClass(ctx context.Context, class string, p *basic.PaginationOptions) (as []*Assignment, total int64, err error)
+	AnonymizeTeacher(ctx context.Context, teacher string) error
+}
+
+type AssignmentMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewAssignmentMongoMapper(config *config.Config) *AssignmentMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, assignmentCollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: classId, Value: 1}, {Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &AssignmentMongoMapper{conn: conn}
+}
+
+func (m *AssignmentMongoMapper) Insert(ctx context.Context, a *Assignment) error {
+	if a.ID.IsZero() {
+		a.ID = primitive.NewObjectID()
+		a.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, a)
+	return err
+}
+
+func (m *AssignmentMongoMapper) FindOne(ctx context.Context, id string) (*Assignment, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	var a Assignment
+	err = m.conn.FindOneNoCache(ctx, &a, bson.M{consts.ID: oid})
+	switch {
+	case err == nil:
+		return &a, nil
+	case errors.Is(err, monc.ErrNotFound):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindManyByClass 分页获取班级的作业, 按布置时间倒序
+func (m *AssignmentMongoMapper) FindManyByClass(ctx context.Context, class string, p *basic.PaginationOptions) (as []*Assignment, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	as = make([]*Assignment, 0, limit)
+	err = m.conn.Find(ctx, &as, bson.M{classId: class}, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, bson.M{classId: class})
+	if err != nil {
+		return nil, 0, err
+	}
+	return as, total, nil
+}
+
+// AnonymizeTeacher 清除教师布置的作业中教师的身份, 作业及学生的提交保留
+func (m *AssignmentMongoMapper) AnonymizeTeacher(ctx context.Context, teacher string) error {
+	_, err := m.conn.UpdateManyNoCache(ctx, bson.M{teacherId: teacher}, bson.M{"$set": bson.M{teacherId: ""}})
+	return err
+}
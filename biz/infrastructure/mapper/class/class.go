@@ -0,0 +1,45 @@
+package class
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type (
+	// Class 是教师创建的班级, 学生填写班级码加入
+	Class struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		TeacherId  string             `bson:"teacher_id" json:"teacherId"`
+		Name       string             `bson:"name" json:"name"`
+		Code       string             `bson:"code" json:"code"` // 班级码
+		School     string             `bson:"school" json:"school"`
+		Grade      int64              `bson:"grade" json:"grade"`
+		CreateTime time.Time          `bson:"create_time" json:"createTime"`
+	}
+
+	// Member 是班级中的一名学生
+	Member struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		ClassId    string             `bson:"class_id" json:"classId"`
+		UserId     string             `bson:"user_id" json:"userId"`
+		CreateTime time.Time          `bson:"create_time" json:"createTime"` // 加入时间
+	}
+
+	// Assignment 是教师在班级中布置的写作作业, 学生的批改记录通过log.Log.Assignment关联到作业
+	Assignment struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		ClassId    string             `bson:"class_id" json:"classId"`
+		TeacherId  string             `bson:"teacher_id" json:"teacherId"`
+		Title      string             `bson:"title" json:"title"`
+		Prompt     string             `bson:"prompt" json:"prompt"`          // 写作要求
+		EssayType  string             `bson:"essay_type" json:"essayType"`   // 作文体裁, 学生提交时未指定则使用该体裁批改
+		Grade      int64              `bson:"grade" json:"grade"`            // 年级, 学生提交时未指定则使用该年级批改
+		Deadline   time.Time          `bson:"deadline" json:"deadline"`      // 截止时间, 之后不能再提交
+		CreateTime time.Time          `bson:"create_time" json:"createTime"` // 布置时间
+	}
+)
+
+// Open 判断作业当前是否还可以提交
+func (a *Assignment) Open(now time.Time) bool {
+	return now.Before(a.Deadline)
+}
@@ -0,0 +1,124 @@
+package class
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	classCollectionName = "class"
+	alphabet            = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // 去掉了容易混淆的I、O、0、1
+	codeLength          = 6
+	maxRetry            = 10
+	code                = "code"
+	teacherId           = "teacher_id"
+	classId             = "class_id"
+)
+
+type IClassMongoMapper interface {
+	Insert(ctx context.Context, c *Class) error
+	FindOne(ctx context.Context, id string) (*Class, error)
+	FindOneByCode(ctx context.Context, code string) (*Class, error)
+	FindManyByTeacher(ctx context.Context, teacher string) ([]*Class, error)
+	FindManyByIds(ctx context.Context, ids []string) ([]*Class, error)
+	AnonymizeTeacher(ctx context.Context, teacher string) error
+}
+
+type ClassMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewClassMongoMapper(config *config.Config) *ClassMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, classCollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: code, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &ClassMongoMapper{conn: conn}
+}
+
+// Insert 为班级生成随机班级码并插入, 与已有班级码冲突时重新生成
+func (m *ClassMongoMapper) Insert(ctx context.Context, c *Class) error {
+	c.ID = primitive.NewObjectID()
+	c.CreateTime = time.Now()
+	for i := 0; i < maxRetry; i++ {
+		s, err := util.RandomCode(alphabet, codeLength)
+		if err != nil {
+			return err
+		}
+		c.Code = s
+		_, err = m.conn.InsertOneNoCache(ctx, c)
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+	return consts.ErrCreateClass
+}
+
+func (m *ClassMongoMapper) FindOne(ctx context.Context, id string) (*Class, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	return m.findOne(ctx, bson.M{consts.ID: oid})
+}
+
+func (m *ClassMongoMapper) FindOneByCode(ctx context.Context, s string) (*Class, error) {
+	return m.findOne(ctx, bson.M{code: s})
+}
+
+// FindManyByTeacher 获取教师创建的所有班级, 按创建时间正序
+func (m *ClassMongoMapper) FindManyByTeacher(ctx context.Context, teacher string) ([]*Class, error) {
+	cs := make([]*Class, 0)
+	err := m.conn.Find(ctx, &cs, bson.M{teacherId: teacher}, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+func (m *ClassMongoMapper) FindManyByIds(ctx context.Context, ids []string) ([]*Class, error) {
+	oids := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+			oids = append(oids, oid)
+		}
+	}
+	cs := make([]*Class, 0, len(oids))
+	err := m.conn.Find(ctx, &cs, bson.M{consts.ID: bson.M{"$in": oids}}, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// AnonymizeTeacher 清除教师创建的班级中教师的身份, 班级及学生的提交保留
+func (m *ClassMongoMapper) AnonymizeTeacher(ctx context.Context, teacher string) error {
+	_, err := m.conn.UpdateManyNoCache(ctx, bson.M{teacherId: teacher}, bson.M{"$set": bson.M{teacherId: ""}})
+	return err
+}
+
+func (m *ClassMongoMapper) findOne(ctx context.Context, filter bson.M) (*Class, error) {
+	var c Class
+	err := m.conn.FindOneNoCache(ctx, &c, filter)
+	switch {
+	case err == nil:
+		return &c, nil
+	case errors.Is(err, monc.ErrNotFound):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,116 @@
+package class
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const memberCollectionName = "class_member"
+
+type IMemberMongoMapper interface {
+	Insert(ctx context.Context, mb *Member) error
+	FindOne(ctx context.Context, class, userId string) (*Member, error)
+	FindManyByClass(ctx context.Context, class string) ([]*Member, error)
+	FindManyByUserId(ctx context.Context, userId string) ([]*Member, error)
+	Count(ctx context.Context, class string) (int64, error)
+	Delete(ctx context.Context, class, userId string) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type MemberMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMemberMongoMapper(config *config.Config) *MemberMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, memberCollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: classId, Value: 1}, {Key: consts.UserID, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	_, err = conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.UserID, Value: 1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MemberMongoMapper{conn: conn}
+}
+
+// Insert 将学生加入班级, 已加入时返回consts.ErrClassJoined
+func (m *MemberMongoMapper) Insert(ctx context.Context, mb *Member) error {
+	if mb.ID.IsZero() {
+		mb.ID = primitive.NewObjectID()
+		mb.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, mb)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrClassJoined
+	}
+	return err
+}
+
+// FindOne 查询学生在班级中的记录, 未加入时返回consts.ErrNotFound
+func (m *MemberMongoMapper) FindOne(ctx context.Context, class, userId string) (*Member, error) {
+	var mb Member
+	err := m.conn.FindOneNoCache(ctx, &mb, bson.M{classId: class, consts.UserID: userId})
+	switch {
+	case err == nil:
+		return &mb, nil
+	case errors.Is(err, monc.ErrNotFound):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindManyByClass 获取班级的所有学生, 按加入时间正序
+func (m *MemberMongoMapper) FindManyByClass(ctx context.Context, class string) ([]*Member, error) {
+	return m.find(ctx, bson.M{classId: class})
+}
+
+// FindManyByUserId 获取学生加入的所有班级, 按加入时间正序
+func (m *MemberMongoMapper) FindManyByUserId(ctx context.Context, userId string) ([]*Member, error) {
+	return m.find(ctx, bson.M{consts.UserID: userId})
+}
+
+func (m *MemberMongoMapper) Count(ctx context.Context, class string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{classId: class})
+}
+
+// Delete 将学生移出班级, 未加入时返回consts.ErrNotFound
+func (m *MemberMongoMapper) Delete(ctx context.Context, class, userId string) error {
+	n, err := m.conn.DeleteMany(ctx, bson.M{classId: class, consts.UserID: userId})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByUserId 将学生移出所有班级
+func (m *MemberMongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
+
+func (m *MemberMongoMapper) find(ctx context.Context, filter bson.M) ([]*Member, error) {
+	mbs := make([]*Member, 0)
+	err := m.conn.Find(ctx, &mbs, filter, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return mbs, nil
+}
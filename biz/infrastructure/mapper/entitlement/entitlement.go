@@ -0,0 +1,34 @@
+package entitlement
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// 权益类型
+const (
+	TypeUnlimited = "unlimited" // 有效期内不限次数
+	TypeMonthly   = "monthly"   // 每月固定额度, 按开通日期每月重置
+	TypeExpiring  = "expiring"  // 一次性额度, 到期作废
+)
+
+// ConsumeOrder 批改时消耗权益的顺序, 都不可用时才扣除User.Count
+// 同一类型中优先消耗最早到期的权益
+var ConsumeOrder = []string{TypeUnlimited, TypeMonthly, TypeExpiring}
+
+// Entitlement 是用户开通套餐后获得的一项权益
+type Entitlement struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserId     string             `bson:"user_id" json:"userId"`
+	PlanId     string             `bson:"plan_id" json:"planId"`
+	Name       string             `bson:"name" json:"name"`
+	Type       string             `bson:"type" json:"type"`
+	Total      int64              `bson:"total" json:"total"`         // 每月额度或总次数
+	Remaining  int64              `bson:"remaining" json:"remaining"` // 剩余次数
+	Used       int64              `bson:"used" json:"used"`           // 累计使用次数
+	StartTime  time.Time          `bson:"start_time" json:"startTime"`
+	EndTime    time.Time          `bson:"end_time" json:"endTime"`
+	ResetTime  time.Time          `bson:"reset_time,omitempty" json:"resetTime,omitempty"` // 每月额度的下次重置时间
+	RefId      string             `bson:"ref_id,omitempty" json:"refId,omitempty"`         // 开通来源, 如购买套餐的订单id
+	CreateTime time.Time          `bson:"create_time" json:"createTime"`
+}
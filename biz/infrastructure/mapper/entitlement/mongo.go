@@ -0,0 +1,136 @@
+package entitlement
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "entitlement"
+	typ            = "type"
+	remaining      = "remaining"
+	used           = "used"
+	startTime      = "start_time"
+	endTime        = "end_time"
+	resetTime      = "reset_time"
+	refId          = "ref_id"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, e *Entitlement) error
+	FindActive(ctx context.Context, userId string, now time.Time) ([]*Entitlement, error)
+	Use(ctx context.Context, userId, t string, now time.Time) (*Entitlement, error)
+	FindDue(ctx context.Context, now time.Time) ([]*Entitlement, error)
+	Reset(ctx context.Context, e *Entitlement, next time.Time) error
+	Revoke(ctx context.Context, userId, ref string, now time.Time) error
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.UserID, Value: 1}, {Key: typ, Value: 1}, {Key: endTime, Value: 1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 同一来源只开通一次权益, 重试发放订单权益时不会重复开通
+	_, err = conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: refId, Value: 1}},
+		Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{refId: bson.M{"$exists": true}}),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+// Insert 插入权益, 来源相同的权益已存在时返回consts.ErrEntitlementExists
+func (m *MongoMapper) Insert(ctx context.Context, e *Entitlement) error {
+	if e.ID.IsZero() {
+		e.ID = primitive.NewObjectID()
+		e.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, e)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrEntitlementExists
+	}
+	return err
+}
+
+// FindActive 获取用户在now时生效的所有权益, 按到期时间排序
+func (m *MongoMapper) FindActive(ctx context.Context, userId string, now time.Time) ([]*Entitlement, error) {
+	es := make([]*Entitlement, 0)
+	err := m.conn.Find(ctx, &es, bson.M{
+		consts.UserID: userId,
+		startTime:     bson.M{"$lte": now},
+		endTime:       bson.M{"$gt": now},
+	}, options.Find().SetSort(bson.M{endTime: 1}))
+	return es, err
+}
+
+// Use 消耗一次指定类型中最早到期的可用权益, 没有可用权益时返回consts.ErrNotFound
+func (m *MongoMapper) Use(ctx context.Context, userId, t string, now time.Time) (*Entitlement, error) {
+	filter := bson.M{
+		consts.UserID: userId,
+		typ:           t,
+		startTime:     bson.M{"$lte": now},
+		endTime:       bson.M{"$gt": now},
+	}
+	update := bson.M{"$inc": bson.M{used: 1}}
+	if t != TypeUnlimited {
+		filter[remaining] = bson.M{"$gt": 0}
+		update["$inc"] = bson.M{used: 1, remaining: -1}
+	}
+	e := &Entitlement{}
+	err := m.conn.FindOneAndUpdateNoCache(ctx, e, filter, update,
+		options.FindOneAndUpdate().SetSort(bson.M{endTime: 1}).SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return e, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindDue 获取需要重置额度的每月权益
+func (m *MongoMapper) FindDue(ctx context.Context, now time.Time) ([]*Entitlement, error) {
+	es := make([]*Entitlement, 0)
+	err := m.conn.Find(ctx, &es, bson.M{
+		typ:       TypeMonthly,
+		resetTime: bson.M{"$lte": now},
+		endTime:   bson.M{"$gt": now},
+	})
+	return es, err
+}
+
+// Reset 重置每月额度, 以原重置时间为条件保证多次执行只生效一次
+func (m *MongoMapper) Reset(ctx context.Context, e *Entitlement, next time.Time) error {
+	_, err := m.conn.UpdateOneNoCache(ctx, bson.M{consts.ID: e.ID, resetTime: e.ResetTime}, bson.M{
+		"$set": bson.M{remaining: e.Total, resetTime: next},
+	})
+	return err
+}
+
+// Revoke 使来源为ref的权益立即失效
+func (m *MongoMapper) Revoke(ctx context.Context, userId, ref string, now time.Time) error {
+	_, err := m.conn.UpdateManyNoCache(ctx, bson.M{
+		consts.UserID: userId,
+		refId:         ref,
+		endTime:       bson.M{"$gt": now},
+	}, bson.M{"$set": bson.M{endTime: now}})
+	return err
+}
@@ -32,6 +32,7 @@ type (
 		Question    string    `bson:"question" json:"question"`       // 问题描述
 		Explanation string    `bson:"explanation" json:"explanation"` // 题目解答
 		Options     []*Option `bson:"options" json:"options"`         // 题目选项
+		Hidden      bool      `bson:"hidden" json:"hidden"`           // 被举报过多后屏蔽，不再展示和复用
 	}
 
 	// Option 是一道选择题中的选项
@@ -60,3 +61,14 @@ type (
 		Score  int64  `bson:"score" json:"score"`   // 得分
 	}
 )
+
+// VisibleChoiceQuestions 返回未被屏蔽的选择题
+func (q *Question) VisibleChoiceQuestions() []*ChoiceQuestion {
+	cqs := make([]*ChoiceQuestion, 0, len(q.ChoiceQuestions))
+	for _, cq := range q.ChoiceQuestions {
+		if !cq.Hidden {
+			cqs = append(cqs, cq)
+		}
+	}
+	return cqs
+}
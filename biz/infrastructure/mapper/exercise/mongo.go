@@ -8,21 +8,34 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/monc"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/net/context"
 	"time"
 )
 
 const (
-	prefixKeyCacheKey = "cache:exercise"
-	CollectionName    = "exercise"
+	prefixKeyCacheKey    = "cache:exercise"
+	CollectionName       = "exercise"
+	updateTime           = "update_time"
+	historyRecords       = "history.records"
+	choiceQuestionId     = "question.choice_questions.id"
+	choiceQuestionHidden = "question.choice_questions.$.hidden"
 )
 
 type IMongoMapper interface {
 	Insert(ctx context.Context, e *Exercise) error
 	Update(ctx context.Context, e *Exercise) error
-	FindManyByLogId(ctx context.Context, logId string, p *basic.PaginationOptions) (exercise []*Exercise, total int64, err error)
+	Delete(ctx context.Context, e *Exercise) error
+	FindManyByLogId(ctx context.Context, userId, logId string, p *basic.PaginationOptions) (exercise []*Exercise, total int64, err error)
+	CountByLogId(ctx context.Context, userId, logId string) (int64, error)
 	FindOneById(ctx context.Context, id string) (*Exercise, error)
+	SumFirstScores(ctx context.Context, start, end time.Time) (map[string]int64, error)
+	FindAllByUserId(ctx context.Context, userId string) (exercises []*Exercise, err error)
+	DeleteByUserId(ctx context.Context, userId string) error
+	CountByUserId(ctx context.Context, userId string) (int64, error)
+	AddRecords(ctx context.Context, id primitive.ObjectID, rds *Records) (int, error)
+	HideQuestion(ctx context.Context, id primitive.ObjectID, questionId string) error
 }
 
 type MongoMapper struct {
@@ -31,6 +44,13 @@ type MongoMapper struct {
 
 func NewMongoMapper(config *config.Config) *MongoMapper {
 	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	// 重建排行榜时按作答时间筛选练习
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: historyRecords + "." + consts.CreateTime, Value: 1}},
+	})
+	if err != nil {
+		panic(err)
+	}
 	return &MongoMapper{conn: conn}
 }
 
@@ -51,10 +71,47 @@ func (m *MongoMapper) Update(ctx context.Context, e *Exercise) error {
 	return err
 }
 
-func (m *MongoMapper) FindManyByLogId(ctx context.Context, logId string, p *basic.PaginationOptions) (exercise []*Exercise, total int64, err error) {
+// AddRecords 追加一次作答记录, 只修改作答记录, 不会覆盖并发的其他修改, 返回追加后的作答次数
+func (m *MongoMapper) AddRecords(ctx context.Context, id primitive.ObjectID, rds *Records) (int, error) {
+	key := prefixKeyCacheKey + id.Hex()
+	e := &Exercise{}
+	err := m.conn.FindOneAndUpdate(ctx, key, e, bson.M{consts.ID: id}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			historyRecords: bson.M{"$concatArrays": bson.A{
+				bson.M{"$ifNull": bson.A{"$" + historyRecords, bson.A{}}},
+				bson.A{bson.M{"$literal": rds}},
+			}},
+			updateTime: time.Now(),
+		}}},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if err != nil {
+		return 0, err
+	}
+	return len(e.History.Records), nil
+}
+
+// HideQuestion 屏蔽练习中的一道题目, 只修改该题的屏蔽状态
+func (m *MongoMapper) HideQuestion(ctx context.Context, id primitive.ObjectID, questionId string) error {
+	key := prefixKeyCacheKey + id.Hex()
+	_, err := m.conn.UpdateOne(ctx, key, bson.M{consts.ID: id, choiceQuestionId: questionId},
+		bson.M{"$set": bson.M{choiceQuestionHidden: true}})
+	return err
+}
+
+// Delete 软删除一套练习
+func (m *MongoMapper) Delete(ctx context.Context, e *Exercise) error {
+	now := time.Now()
+	e.Status = consts.DeleteStatus
+	e.UpdateTime = now
+	e.DeleteTime = now
+	return m.Update(ctx, e)
+}
+
+func (m *MongoMapper) FindManyByLogId(ctx context.Context, userId, logId string, p *basic.PaginationOptions) (exercise []*Exercise, total int64, err error) {
 	skip, limt := util.ParsePageOpt(p)
 
 	filter := bson.M{
+		consts.UserID: userId,
 		consts.LogId:  logId,
 		consts.Status: bson.M{consts.NotEqual: consts.DeleteStatus},
 	}
@@ -80,6 +137,14 @@ func (m *MongoMapper) FindManyByLogId(ctx context.Context, logId string, p *basi
 	return data, total, nil
 }
 
+// CountByLogId 统计用户在一条批改记录下生成过的练习数, 包括已删除的
+func (m *MongoMapper) CountByLogId(ctx context.Context, userId, logId string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{
+		consts.UserID: userId,
+		consts.LogId:  logId,
+	})
+}
+
 func (m *MongoMapper) FindOneById(ctx context.Context, id string) (*Exercise, error) {
 	oid, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
@@ -96,3 +161,77 @@ func (m *MongoMapper) FindOneById(ctx context.Context, id string) (*Exercise, er
 	err = m.conn.FindOne(ctx, key, e, filter)
 	return e, err
 }
+
+// SumFirstScores 按用户汇总首次提交时间在[start, end)内的练习得分, 同一套练习只计首次提交
+func (m *MongoMapper) SumFirstScores(ctx context.Context, start, end time.Time) (map[string]int64, error) {
+	var data []struct {
+		UserId string `bson:"_id"`
+		Score  int64  `bson:"score"`
+	}
+	err := m.conn.Aggregate(ctx, &data, []bson.M{
+		// 首次提交在范围内时必有作答记录在范围内, 先用索引筛选
+		{"$match": bson.M{
+			consts.Status: bson.M{consts.NotEqual: consts.DeleteStatus},
+			historyRecords: bson.M{"$elemMatch": bson.M{
+				consts.CreateTime: bson.M{"$gte": start, "$lt": end},
+			}},
+		}},
+		{"$project": bson.M{
+			consts.UserID: 1,
+			"first":       bson.M{"$arrayElemAt": bson.A{"$history.records", 0}},
+		}},
+		{"$match": bson.M{
+			"first.create_time": bson.M{"$gte": start, "$lt": end},
+		}},
+		{"$group": bson.M{
+			consts.ID: "$" + consts.UserID,
+			"score":   bson.M{"$sum": "$first.score"},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	scores := make(map[string]int64, len(data))
+	for _, d := range data {
+		scores[d.UserId] = d.Score
+	}
+	return scores, nil
+}
+
+// FindAllByUserId 获取用户所有的练习, 用于导出个人数据
+func (m *MongoMapper) FindAllByUserId(ctx context.Context, userId string) (exercises []*Exercise, err error) {
+	exercises = make([]*Exercise, 0)
+	err = m.conn.Find(ctx, &exercises, bson.M{consts.UserID: userId}, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return exercises, nil
+}
+
+// DeleteByUserId 删除用户所有的练习, 并清除按id缓存的练习, 避免删除后仍能读到
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	var es []*Exercise
+	err := m.conn.Find(ctx, &es, bson.M{consts.UserID: userId}, options.Find().SetProjection(bson.M{consts.ID: 1}))
+	if err != nil {
+		return err
+	}
+	if len(es) == 0 {
+		return nil
+	}
+	if _, err = m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId}); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(es))
+	for _, e := range es {
+		keys = append(keys, prefixKeyCacheKey+e.ID.Hex())
+	}
+	return m.conn.DelCache(ctx, keys...)
+}
+
+// CountByUserId 统计用户未删除的练习数
+func (m *MongoMapper) CountByUserId(ctx context.Context, userId string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{
+		consts.UserID: userId,
+		consts.Status: bson.M{consts.NotEqual: consts.DeleteStatus},
+	})
+}
@@ -0,0 +1,28 @@
+package exercise
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type (
+	// Report 是用户对练习中某一道题目的一次举报
+	Report struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		ExerciseId string             `bson:"exercise_id" json:"exerciseId"` // 练习ID
+		QuestionId string             `bson:"question_id" json:"questionId"` // 题目ID
+		UserId     string             `bson:"user_id" json:"userId"`         // 举报用户ID
+		Reason     int64              `bson:"reason" json:"reason"`          // 举报原因
+		Comment    string             `bson:"comment" json:"comment"`        // 补充说明
+		CreateTime time.Time          `bson:"create_time" json:"createTime"` // 举报时间
+	}
+
+	// ReportSummary 是一道题目的举报汇总
+	ReportSummary struct {
+		ExerciseId string   `bson:"exercise_id" json:"exerciseId"` // 练习ID
+		QuestionId string   `bson:"question_id" json:"questionId"` // 题目ID
+		Count      int64    `bson:"count" json:"count"`            // 举报次数
+		Reasons    []int64  `bson:"reasons" json:"reasons"`        // 所有举报原因
+		Comments   []string `bson:"comments" json:"comments"`      // 所有补充说明
+	}
+)
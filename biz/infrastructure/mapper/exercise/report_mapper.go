@@ -0,0 +1,128 @@
+package exercise
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	reportCollectionName = "exercise_report"
+)
+
+type IReportMongoMapper interface {
+	Insert(ctx context.Context, r *Report) error
+	FindOneByUser(ctx context.Context, exerciseId, questionId, userId string) (*Report, error)
+	Summarize(ctx context.Context, p *basic.PaginationOptions) (summaries []*ReportSummary, total int64, err error)
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type ReportMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewReportMongoMapper(config *config.Config) *ReportMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, reportCollectionName, config.Cache)
+	// 同一用户对同一题目只能举报一次
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: consts.UserID, Value: 1}, {Key: consts.ExerciseId, Value: 1}, {Key: consts.QuestionId, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &ReportMongoMapper{conn: conn}
+}
+
+// Insert 插入举报记录, 已举报过时返回consts.ErrRepeatReport
+func (m *ReportMongoMapper) Insert(ctx context.Context, r *Report) error {
+	if r.ID.IsZero() {
+		r.ID = primitive.NewObjectID()
+		r.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, r)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrRepeatReport
+	}
+	return err
+}
+
+func (m *ReportMongoMapper) FindOneByUser(ctx context.Context, exerciseId, questionId, userId string) (*Report, error) {
+	r := &Report{}
+	err := m.conn.FindOneNoCache(ctx, r, bson.M{
+		consts.ExerciseId: exerciseId,
+		consts.QuestionId: questionId,
+		consts.UserID:     userId,
+	})
+	switch {
+	case err == nil:
+		return r, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Summarize 按题目聚合举报记录，举报次数多的题目排在前面
+func (m *ReportMongoMapper) Summarize(ctx context.Context, p *basic.PaginationOptions) (summaries []*ReportSummary, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+
+	group := bson.M{"$group": bson.M{
+		consts.ID: bson.M{
+			consts.ExerciseId: "$" + consts.ExerciseId,
+			consts.QuestionId: "$" + consts.QuestionId,
+		},
+		"count":    bson.M{"$sum": 1},
+		"reasons":  bson.M{"$push": "$reason"},
+		"comments": bson.M{"$push": "$comment"},
+	}}
+	project := bson.M{"$project": bson.M{
+		consts.ID:         0,
+		consts.ExerciseId: "$" + consts.ID + "." + consts.ExerciseId,
+		consts.QuestionId: "$" + consts.ID + "." + consts.QuestionId,
+		"count":           1,
+		"reasons":         1,
+		"comments":        1,
+	}}
+
+	summaries = make([]*ReportSummary, 0, limit)
+	err = m.conn.Aggregate(ctx, &summaries, []bson.M{
+		group,
+		{"$sort": bson.D{{Key: "count", Value: -1}, {Key: consts.ID, Value: 1}}},
+		{"$skip": skip},
+		{"$limit": limit},
+		project,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 被举报过的题目总数
+	var counts []struct {
+		Total int64 `bson:"total"`
+	}
+	err = m.conn.Aggregate(ctx, &counts, []bson.M{group, {"$count": "total"}})
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(counts) > 0 {
+		total = counts[0].Total
+	}
+	return summaries, total, nil
+}
+
+// DeleteByUserId 删除用户的所有举报
+func (m *ReportMongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
@@ -8,11 +8,59 @@ import (
 
 type Feedback struct {
 	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
-	UserId     string             `bson:"user_id" json:"userId"`         // 提交反馈的用户ID
-	Type       int64              `bson:"type" json:"type"`              // 反馈类型（如：建议、错误报告、功能请求等）
-	Content    string             `bson:"content" json:"content"`        // 反馈内容
-	Status     int                `bson:"status" json:"status"`          // 处理状态（如：未处理、处理中、已处理）
-	Images     []string           `bson:"images" json:"images"`          // 用户上传的图片URL列表（可选）
-	CreateTime time.Time          `bson:"create_time" json:"createTime"` // 创建时间
-	UpdateTime time.Time          `bson:"update_time" json:"updateTime"` // 更新时间
+	UserId     string             `bson:"user_id" json:"userId"`            // 提交反馈的用户ID
+	Type       int64              `bson:"type" json:"type"`                 // 反馈类型（如：建议、错误报告、功能请求等）
+	Content    string             `bson:"content" json:"content"`           // 反馈内容
+	Status     int                `bson:"status" json:"status"`             // 处理状态（如：未处理、处理中、已处理）
+	Images     []string           `bson:"images" json:"images"`             // 用户上传的图片URL列表（可选）
+	Owner      string             `bson:"owner,omitempty" json:"owner"`     // 负责处理的管理员
+	Notes      []*Note            `bson:"notes,omitempty" json:"notes"`     // 处理记录, 仅管理员可见
+	Replies    []*Reply           `bson:"replies,omitempty" json:"replies"` // 给用户的回复
+	Ref        *Ref               `bson:"ref,omitempty" json:"ref"`         // 反馈针对的批改记录或题目
+	CreateTime time.Time          `bson:"create_time" json:"createTime"`    // 创建时间
+	UpdateTime time.Time          `bson:"update_time" json:"updateTime"`    // 更新时间
+}
+
+// Ref 是反馈针对的批改记录或练习中的一道题目
+type Ref struct {
+	LogId      string `bson:"log_id,omitempty" json:"logId"`
+	ExerciseId string `bson:"exercise_id,omitempty" json:"exerciseId"`
+	QuestionId string `bson:"question_id,omitempty" json:"questionId"`
+}
+
+// Stat 是针对同一资源的反馈数量, 用于发现质量问题
+type Stat struct {
+	Ref   *Ref  `bson:"_id"`
+	Count int64 `bson:"count"`
+}
+
+// Note 是一次处理状态的变更
+type Note struct {
+	AdminId    string    `bson:"admin_id" json:"adminId"`
+	Status     int       `bson:"status" json:"status"` // 变更后的状态
+	Content    string    `bson:"content" json:"content"`
+	CreateTime time.Time `bson:"create_time" json:"createTime"`
+}
+
+// Reply 是管理员对用户的一次回复
+type Reply struct {
+	AdminId    string    `bson:"admin_id" json:"adminId"`
+	Content    string    `bson:"content" json:"content"`
+	CreateTime time.Time `bson:"create_time" json:"createTime"`
+}
+
+// 处理状态
+const (
+	StatusPending    = 0 // 未处理
+	StatusProcessing = 1 // 处理中
+	StatusDone       = 2 // 已处理
+)
+
+// Filter 是管理员筛选反馈的条件, 零值表示不限
+type Filter struct {
+	UserId string
+	Type   *int64
+	Status *int64
+	Start  time.Time
+	End    time.Time
 }
@@ -2,20 +2,37 @@ package feedback
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
 	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 const (
 	prefixKeyCacheKey = "cache:feedback"
 	CollectionName    = "feedback"
+	updateTime        = "update_time"
 )
 
 type IMongoMapper interface {
 	Insert(ctx context.Context, f *Feedback) error
+	FindOne(ctx context.Context, id string) (*Feedback, error)
+	FindMany(ctx context.Context, filter *Filter, p *basic.PaginationOptions) (fs []*Feedback, total int64, err error)
+	Assign(ctx context.Context, id, owner string) error
+	UpdateStatus(ctx context.Context, id string, note *Note) error
+	AddReply(ctx context.Context, id string, reply *Reply) error
+	CountByRef(ctx context.Context, ref *Ref) (int64, error)
+	Stats(ctx context.Context, typ *int64, limit int64) ([]*Stat, error)
+	FindAllByUserId(ctx context.Context, userId string) ([]*Feedback, error)
+	DeleteByUserId(ctx context.Context, userId string) error
 }
 
 type MongoMapper struct {
@@ -36,3 +53,155 @@ func (m *MongoMapper) Insert(ctx context.Context, f *Feedback) error {
 	_, err := m.conn.InsertOneNoCache(ctx, f)
 	return err
 }
+
+func (m *MongoMapper) FindOne(ctx context.Context, id string) (*Feedback, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	f := &Feedback{}
+	err = m.conn.FindOneNoCache(ctx, f, bson.M{consts.ID: oid})
+	switch {
+	case err == nil:
+		return f, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindMany 按条件分页获取反馈, 按提交时间倒序
+func (m *MongoMapper) FindMany(ctx context.Context, filter *Filter, p *basic.PaginationOptions) (fs []*Feedback, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	f := bson.M{}
+	if filter.UserId != "" {
+		f[consts.UserID] = filter.UserId
+	}
+	if filter.Type != nil {
+		f["type"] = *filter.Type
+	}
+	if filter.Status != nil {
+		f[consts.Status] = *filter.Status
+	}
+	if !filter.Start.IsZero() || !filter.End.IsZero() {
+		t := bson.M{}
+		if !filter.Start.IsZero() {
+			t["$gte"] = filter.Start
+		}
+		if !filter.End.IsZero() {
+			t["$lt"] = filter.End
+		}
+		f[consts.CreateTime] = t
+	}
+	fs = make([]*Feedback, 0, limit)
+	err = m.conn.Find(ctx, &fs, f, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	return fs, total, nil
+}
+
+// Assign 指定负责处理反馈的管理员
+func (m *MongoMapper) Assign(ctx context.Context, id, owner string) error {
+	return m.update(ctx, id, bson.M{"$set": bson.M{"owner": owner, updateTime: time.Now()}})
+}
+
+// UpdateStatus 变更处理状态并追加处理记录
+func (m *MongoMapper) UpdateStatus(ctx context.Context, id string, note *Note) error {
+	note.CreateTime = time.Now()
+	return m.update(ctx, id, bson.M{
+		"$set":  bson.M{consts.Status: note.Status, updateTime: note.CreateTime},
+		"$push": bson.M{"notes": note},
+	})
+}
+
+// AddReply 追加一条给用户的回复
+func (m *MongoMapper) AddReply(ctx context.Context, id string, reply *Reply) error {
+	reply.CreateTime = time.Now()
+	return m.update(ctx, id, bson.M{
+		"$set":  bson.M{updateTime: reply.CreateTime},
+		"$push": bson.M{"replies": reply},
+	})
+}
+
+// CountByRef 统计针对同一批改记录或题目的反馈数量
+func (m *MongoMapper) CountByRef(ctx context.Context, ref *Ref) (int64, error) {
+	return m.conn.CountDocuments(ctx, refFilter(ref))
+}
+
+// Stats 按针对的资源汇总反馈数量, 按数量倒序返回前limit项
+func (m *MongoMapper) Stats(ctx context.Context, typ *int64, limit int64) ([]*Stat, error) {
+	match := bson.M{"ref": bson.M{"$exists": true}}
+	if typ != nil {
+		match["type"] = *typ
+	}
+	stats := make([]*Stat, 0, limit)
+	err := m.conn.Aggregate(ctx, &stats, []bson.M{
+		{"$match": match},
+		{"$group": bson.M{
+			consts.ID: "$ref",
+			"count":   bson.M{"$sum": 1},
+		}},
+		{"$sort": bson.D{{Key: "count", Value: -1}}},
+		{"$limit": limit},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func refFilter(ref *Ref) bson.M {
+	f := bson.M{}
+	if ref.LogId != "" {
+		f["ref."+consts.LogId] = ref.LogId
+	}
+	if ref.ExerciseId != "" {
+		f["ref."+consts.ExerciseId] = ref.ExerciseId
+		f["ref."+consts.QuestionId] = ref.QuestionId
+	} else {
+		// 针对批改记录的反馈不包含针对其练习题目的反馈
+		f["ref."+consts.ExerciseId] = bson.M{"$exists": false}
+	}
+	return f
+}
+
+func (m *MongoMapper) update(ctx context.Context, id string, update bson.M) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	res, err := m.conn.UpdateByIDNoCache(ctx, oid, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
+
+// FindAllByUserId 获取用户提交的所有反馈, 用于导出个人数据
+func (m *MongoMapper) FindAllByUserId(ctx context.Context, userId string) ([]*Feedback, error) {
+	fs := make([]*Feedback, 0)
+	err := m.conn.Find(ctx, &fs, bson.M{consts.UserID: userId}, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// DeleteByUserId 删除用户提交的所有反馈
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
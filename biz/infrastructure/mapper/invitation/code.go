@@ -6,8 +6,19 @@ import (
 )
 
 type Code struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	UserId    string             `bson:"user_id"`
-	Code      string             `bson:"code"`
-	Timestamp time.Time          `bson:"timestamp"`
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	UserId      string             `bson:"user_id"`
+	Code        string             `bson:"code"`
+	Timestamp   time.Time          `bson:"timestamp"`
+	Milestones  []int64            `bson:"milestones,omitempty"`  // 已发放奖励的邀请里程碑
+	DeviceId    string             `bson:"device_id,omitempty"`   // 邀请者最近获取邀请码时的设备
+	IP          string             `bson:"ip,omitempty"`          // 邀请者最近获取邀请码时的IP
+	Custom      bool               `bson:"custom,omitempty"`      // 是否为管理员设置的自定义邀请码
+	ExpireTime  time.Time          `bson:"expire_time,omitempty"` // 过期时间, 为空时永不过期
+	Deactivated bool               `bson:"deactivated,omitempty"` // 是否已被管理员停用
+}
+
+// Valid 判断邀请码当前是否可以被填写
+func (c *Code) Valid(now time.Time) bool {
+	return !c.Deactivated && (c.ExpireTime.IsZero() || now.Before(c.ExpireTime))
 }
@@ -4,61 +4,168 @@ import (
 	"errors"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
 	"github.com/zeromicro/go-zero/core/stores/monc"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/net/context"
-	"math/rand"
 	"time"
 )
 
 const (
 	codePrefixUserCacheKey = "cache:invitation_code"
 	codeCollectionName     = "invitation_code"
-	letters                = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	digits                 = "0123456789"
+	alphabet               = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // 去掉了容易混淆的I、O、0、1
+	codeLength             = 6
+	maxRetry               = 10
+	code                   = "code"
+	custom                 = "custom"
+	expireTime             = "expire_time"
+	deactivated            = "deactivated"
+	milestones             = "milestones"
 )
 
 type ICodeMongoMapper interface {
-	Insert(ctx context.Context, userId string) error
+	Insert(ctx context.Context, userId string) (*Code, error)
+	Update(ctx context.Context, c *Code) error
 	FindOneByUserId(ctx context.Context, userId string) (*Code, error)
+	FindOneByCode(ctx context.Context, code string) (*Code, error)
+	DeleteByUserId(ctx context.Context, userId string) error
 }
 
 type CodeMongoMapper struct {
-	conn *monc.Model
+	conn   *monc.Model
+	unique bool // 邀请码的唯一索引是否建立成功
+}
+
+// generate 生成随机邀请码, 测试中替换以构造冲突
+var generate = func() (string, error) {
+	return util.RandomCode(alphabet, codeLength)
 }
 
 func NewCodeMongoMapper(config *config.Config) *CodeMongoMapper {
 	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, codeCollectionName, config.Cache)
-	return &CodeMongoMapper{
-		conn: conn,
+	m := &CodeMongoMapper{conn: conn}
+	// 早期生成的邀请码可能重复, 建立唯一索引前先为重复的邀请码重新生成
+	ctx := context.Background()
+	if err := m.dedup(ctx); err != nil {
+		log.Error("invitation: dedup codes error %v", err)
+	}
+	// 索引建立失败时不阻止启动, 退化为写入前检查邀请码是否被占用
+	_, err := conn.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: code, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		log.Error("invitation: create unique code index error %v", err)
 	}
+	m.unique = err == nil
+	return m
 }
 
-func (m *CodeMongoMapper) Insert(ctx context.Context, userId string) (*Code, error) {
-	_code := genCode()
-	t := 0
-	for t < 100 {
-		c, err := m.FindOneByCode(ctx, _code)
-		if err == nil && c != nil {
-			t++
-			continue
+// dedup 保留每个重复邀请码中最早的一条, 其余的重新生成, 重新生成的邀请码不再是自定义的
+func (m *CodeMongoMapper) dedup(ctx context.Context) error {
+	var groups []struct {
+		Ids []primitive.ObjectID `bson:"ids"`
+	}
+	err := m.conn.Aggregate(ctx, &groups, mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: consts.Timestamp, Value: 1}, {Key: consts.ID, Value: 1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$" + code, "ids": bson.M{"$push": "$_id"}}}},
+		{{Key: "$match", Value: bson.M{"ids.1": bson.M{"$exists": true}}}},
+	})
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		for _, id := range g.Ids[1:] {
+			s, err := m.free(ctx)
+			if err != nil {
+				return err
+			}
+			if _, err = m.conn.UpdateByIDNoCache(ctx, id, bson.M{"$set": bson.M{code: s, custom: false}}); err != nil {
+				return err
+			}
+			log.Info("invitation: regenerate duplicate code of %s", id.Hex())
 		}
-		break
 	}
-	if t > 100 {
-		return nil, consts.ErrGetInvitation
+	return nil
+}
+
+// free 生成一个未被占用的邀请码
+func (m *CodeMongoMapper) free(ctx context.Context) (string, error) {
+	for i := 0; i < maxRetry; i++ {
+		s, err := generate()
+		if err != nil {
+			return "", err
+		}
+		taken, err := m.taken(ctx, s, primitive.NilObjectID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return s, nil
+		}
 	}
+	return "", consts.ErrGetInvitation
+}
+
+// taken 判断邀请码是否已被id之外的记录占用
+func (m *CodeMongoMapper) taken(ctx context.Context, s string, id primitive.ObjectID) (bool, error) {
+	n, err := m.conn.CountDocuments(ctx, bson.M{code: s, consts.ID: bson.M{consts.NotEqual: id}})
+	return n > 0, err
+}
+
+// Insert 为用户生成随机邀请码并插入, 与已有邀请码冲突时重新生成
+func (m *CodeMongoMapper) Insert(ctx context.Context, userId string) (*Code, error) {
 	c := &Code{
 		ID:        primitive.NewObjectID(),
 		UserId:    userId,
-		Code:      _code,
 		Timestamp: time.Now(),
 	}
+	for i := 0; i < maxRetry; i++ {
+		s, err := generate()
+		if err != nil {
+			return nil, err
+		}
+		if !m.unique {
+			if taken, err := m.taken(ctx, s, c.ID); err != nil {
+				return nil, err
+			} else if taken {
+				continue
+			}
+		}
+		c.Code = s
+		_, err = m.conn.InsertOneNoCache(ctx, c)
+		if !mongo.IsDuplicateKeyError(err) {
+			return c, err
+		}
+	}
+	return nil, consts.ErrGetInvitation
+}
 
-	_, err := m.conn.InsertOneNoCache(ctx, c)
-	return c, err
+// Update 更新邀请码及其有效期, 邀请码已被占用时返回consts.ErrInvitationTaken
+func (m *CodeMongoMapper) Update(ctx context.Context, c *Code) error {
+	if !m.unique {
+		if taken, err := m.taken(ctx, c.Code, c.ID); err != nil {
+			return err
+		} else if taken {
+			return consts.ErrInvitationTaken
+		}
+	}
+	update := bson.M{"$set": bson.M{code: c.Code, custom: c.Custom, deactivated: c.Deactivated}}
+	if c.ExpireTime.IsZero() {
+		update["$unset"] = bson.M{expireTime: ""}
+	} else {
+		update["$set"].(bson.M)[expireTime] = c.ExpireTime
+	}
+	_, err := m.conn.UpdateByIDNoCache(ctx, c.ID, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrInvitationTaken
+	}
+	return err
 }
 
 func (m *CodeMongoMapper) FindOneByUserId(ctx context.Context, userId string) (*Code, error) {
@@ -74,9 +181,9 @@ func (m *CodeMongoMapper) FindOneByUserId(ctx context.Context, userId string) (*
 	}
 }
 
-func (m *CodeMongoMapper) FindOneByCode(ctx context.Context, code string) (*Code, error) {
+func (m *CodeMongoMapper) FindOneByCode(ctx context.Context, s string) (*Code, error) {
 	c := &Code{}
-	err := m.conn.FindOneNoCache(ctx, c, bson.M{"code": code})
+	err := m.conn.FindOneNoCache(ctx, c, bson.M{code: s})
 	switch {
 	case err == nil:
 		return c, nil
@@ -87,24 +194,24 @@ func (m *CodeMongoMapper) FindOneByCode(ctx context.Context, code string) (*Code
 	}
 }
 
-func genCode() string {
-	// 生成四位大写字母
-	letterPart := make([]byte, 4)
-	for i := range letterPart {
-		letterPart[i] = letters[rand.Intn(len(letters))]
-	}
+// UpdateDevice 记录邀请者最近使用的设备和IP, 用于识别自己邀请自己
+func (m *CodeMongoMapper) UpdateDevice(ctx context.Context, userId, device, clientIP string) error {
+	_, err := m.conn.UpdateOneNoCache(ctx, bson.M{consts.UserID: userId}, bson.M{"$set": bson.M{deviceId: device, ip: clientIP}})
+	return err
+}
 
-	// 生成两位数字
-	digitPart := make([]byte, 2)
-	for i := range digitPart {
-		digitPart[i] = digits[rand.Intn(len(digits))]
+// AddMilestone 记录用户已达成的邀请里程碑, 已记录过时返回false, 保证每个里程碑只奖励一次
+func (m *CodeMongoMapper) AddMilestone(ctx context.Context, userId string, count int64) (bool, error) {
+	res, err := m.conn.UpdateOneNoCache(ctx, bson.M{consts.UserID: userId, milestones: bson.M{consts.NotEqual: count}},
+		bson.M{"$push": bson.M{milestones: count}})
+	if err != nil {
+		return false, err
 	}
+	return res.ModifiedCount > 0, nil
+}
 
-	// 将字母和数字混合
-	code := append(letterPart, digitPart...)
-	rand.Shuffle(len(code), func(i, j int) {
-		code[i], code[j] = code[j], code[i]
-	})
-
-	return string(code)
+// DeleteByUserId 删除用户的邀请码
+func (m *CodeMongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
 }
@@ -0,0 +1,173 @@
+package invitation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+	"github.com/zeromicro/go-zero/core/stores/mon"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/net/context"
+	"sync"
+	"testing"
+	"time"
+)
+
+const concurrency = 50
+
+// 并发为不同用户生成邀请码, 生成的邀请码互不相同
+func TestCodeInsertConcurrently(t *testing.T) {
+	m := NewCodeMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	codes := make([]*Code, concurrency)
+	errs := make([]error, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i], errs[i] = m.Insert(ctx, fmt.Sprintf("user%d", i))
+		}(i)
+	}
+	wg.Wait()
+
+	seen := make(map[string]bool, concurrency)
+	for i, c := range codes {
+		if errs[i] != nil {
+			t.Fatalf("Insert user%d error %v", i, errs[i])
+		}
+		if seen[c.Code] {
+			t.Fatalf("code %s generated twice", c.Code)
+		}
+		seen[c.Code] = true
+		found, err := m.FindOneByCode(ctx, c.Code)
+		if err != nil || found.UserId != c.UserId {
+			t.Fatalf("FindOneByCode(%s) = (%v, %v), want user%d", c.Code, found, err, i)
+		}
+	}
+}
+
+// 并发将不同用户的邀请码改为同一个自定义邀请码, 只有一次成功
+func TestCodeUpdateConcurrently(t *testing.T) {
+	m := NewCodeMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	codes := make([]*Code, concurrency)
+	for i := range codes {
+		c, err := m.Insert(ctx, fmt.Sprintf("user%d", i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes[i] = c
+	}
+
+	errs := make([]error, concurrency)
+	var wg sync.WaitGroup
+	for i, c := range codes {
+		wg.Add(1)
+		go func(i int, c *Code) {
+			defer wg.Done()
+			c.Code, c.Custom = "CUSTOM", true
+			errs[i] = m.Update(ctx, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	winner := -1
+	for i, err := range errs {
+		switch {
+		case err == nil && winner >= 0:
+			t.Fatalf("user%d and user%d both took the code", winner, i)
+		case err == nil:
+			winner = i
+		case !errors.Is(err, consts.ErrInvitationTaken):
+			t.Fatalf("Update user%d error %v, want ErrInvitationTaken", i, err)
+		}
+	}
+	if winner < 0 {
+		t.Fatal("no update succeeded")
+	}
+	found, err := m.FindOneByCode(ctx, "CUSTOM")
+	if err != nil || found.UserId != fmt.Sprintf("user%d", winner) {
+		t.Fatalf("FindOneByCode(CUSTOM) = (%v, %v), want user%d", found, err, winner)
+	}
+}
+
+// seed 依次返回给定的邀请码, 用于构造冲突
+func seed(t *testing.T, codes ...string) *int {
+	calls := 0
+	old := generate
+	generate = func() (string, error) {
+		s := codes[calls%len(codes)]
+		calls++
+		return s, nil
+	}
+	t.Cleanup(func() { generate = old })
+	return &calls
+}
+
+// 生成的邀请码与已有的冲突时重新生成
+func TestCodeInsertRetry(t *testing.T) {
+	m := NewCodeMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	seed(t, "AAAAAA")
+	if _, err := m.Insert(ctx, "user0"); err != nil {
+		t.Fatal(err)
+	}
+	calls := seed(t, "AAAAAA", "AAAAAA", "BBBBBB")
+	c, err := m.Insert(ctx, "user1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Code != "BBBBBB" || *calls != 3 {
+		t.Fatalf("Insert = %s after %d tries, want BBBBBB after 3", c.Code, *calls)
+	}
+
+	// 重试次数用尽
+	seed(t, "AAAAAA")
+	if _, err = m.Insert(ctx, "user2"); !errors.Is(err, consts.ErrGetInvitation) {
+		t.Fatalf("Insert error %v, want ErrGetInvitation", err)
+	}
+}
+
+// 建立唯一索引前为已有的重复邀请码重新生成, 保留最早的一条
+func TestCodeDedup(t *testing.T) {
+	c := testutil.LoadDBConfig(t)
+	ctx := context.Background()
+	raw := mon.MustNewModel(c.Mongo.URL, c.Mongo.DB, codeCollectionName)
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := raw.InsertOne(ctx, &Code{
+			ID:        primitive.NewObjectID(),
+			UserId:    fmt.Sprintf("user%d", i),
+			Code:      "AAAAAA",
+			Custom:    true,
+			Timestamp: now.Add(time.Duration(i) * time.Minute),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seed(t, "AAAAAA", "BBBBBB", "CCCCCC")
+	m := NewCodeMongoMapper(c)
+	if !m.unique {
+		t.Fatal("unique index should be created after dedup")
+	}
+	want := map[string]string{"user0": "AAAAAA", "user1": "BBBBBB", "user2": "CCCCCC"}
+	for userId, code := range want {
+		got, err := m.FindOneByUserId(ctx, userId)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Code != code || got.Custom != (userId == "user0") {
+			t.Errorf("%s code = (%s, custom %v), want %s", userId, got.Code, got.Custom, code)
+		}
+	}
+	got, _ := m.FindOneByUserId(ctx, "user1")
+	got.Code = "AAAAAA"
+	if err := m.Update(ctx, got); !errors.Is(err, consts.ErrInvitationTaken) {
+		t.Fatalf("Update to a taken code error %v, want ErrInvitationTaken", err)
+	}
+}
@@ -6,8 +6,52 @@ import (
 )
 
 type Log struct {
-	ID        primitive.ObjectID `bson:"_id,omitempty"`
-	Inviter   string             `bson:"inviter"`
-	Invitee   string             `bson:"invitee"`
-	Timestamp time.Time          `bson:"timestamp"`
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Inviter      string             `bson:"inviter"`
+	Invitee      string             `bson:"invitee"`
+	Status       int64              `bson:"status"`                  // 激活状态
+	DeviceId     string             `bson:"device_id,omitempty"`     // 被邀请者填写邀请码时的设备
+	IP           string             `bson:"ip,omitempty"`            // 被邀请者填写邀请码时的IP
+	Risks        []string           `bson:"risks,omitempty"`         // 命中的风控规则
+	Reviewer     string             `bson:"reviewer,omitempty"`      // 审核的管理员
+	ReviewNote   string             `bson:"review_note,omitempty"`   // 审核备注
+	ReviewTime   time.Time          `bson:"review_time,omitempty"`   // 审核时间
+	Timestamp    time.Time          `bson:"timestamp"`               // 填写邀请码的时间
+	ActivateTime time.Time          `bson:"activate_time,omitempty"` // 被邀请者完成首次批改的时间
+	Unpaid       bool               `bson:"unpaid,omitempty"`        // 已激活但邀请者的奖励尚未发放完成
+}
+
+// 邀请记录状态, 启用延迟奖励前的记录没有status字段, 视为已激活
+const (
+	StatusActivated = 0 // 已激活, 邀请者已获得奖励
+	StatusPending   = 1 // 等待被邀请者完成首次批改
+	StatusReviewing = 2 // 命中风控规则, 等待管理员审核
+	StatusRejected  = 3 // 审核未通过, 双方都不获得奖励
+)
+
+// 风控规则, 命中任意一条的邀请需要管理员审核后才发放奖励
+const (
+	RiskSameDevice   = "same_device"   // 与邀请者或其他被邀请者使用同一设备
+	RiskSameIP       = "same_ip"       // 与邀请者IP相同, 或同一IP下的被邀请者过多
+	RiskNewInviter   = "new_inviter"   // 邀请者注册时间过短
+	RiskOldInvitee   = "old_invitee"   // 被邀请者注册时间过长, 不是新用户
+	RiskSimilarPhone = "similar_phone" // 与邀请者的手机号前7位相同
+	RiskVirtualPhone = "virtual_phone" // 被邀请者使用虚拟运营商号段
+	RiskVelocity     = "velocity"      // 邀请者短时间内邀请人数过多
+)
+
+// VirtualPhonePrefixes 虚拟运营商的手机号段
+var VirtualPhonePrefixes = []string{"162", "165", "167", "170", "171"}
+
+// Milestone 成功邀请的人数达到Count时额外奖励Reward次批改次数
+type Milestone struct {
+	Count  int64
+	Reward int64
+}
+
+// Milestones 所有邀请里程碑, 按人数升序排列
+var Milestones = []*Milestone{
+	{Count: 5, Reward: 5},
+	{Count: 10, Reward: 10},
+	{Count: 20, Reward: 20},
 }
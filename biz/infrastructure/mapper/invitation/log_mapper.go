@@ -2,12 +2,16 @@ package invitation
 
 import (
 	"errors"
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
 	"github.com/zeromicro/go-zero/core/stores/monc"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"golang.org/x/net/context"
 	"time"
 )
@@ -15,11 +19,31 @@ import (
 const (
 	logPrefixUserCacheKey = "cache:invitation_log"
 	logCollectionName     = "invitation_log"
+	activateTime          = "activate_time"
+	deviceId              = "device_id"
+	ip                    = "ip"
+	reviewer              = "reviewer"
+	reviewNote            = "review_note"
+	reviewTime            = "review_time"
+	unpaid                = "unpaid"
 )
 
 type ILogMongoMapper interface {
-	Insert(ctx context.Context, inviter string, invitee string) error
+	Insert(ctx context.Context, l *Log) error
+	FindOne(ctx context.Context, id string) (*Log, error)
 	FindOneByInvitee(ctx context.Context, invitee string) (*Log, error)
+	FindManyByInviter(ctx context.Context, inviter string, p *basic.PaginationOptions) ([]*Log, int64, error)
+	FindManyByStatus(ctx context.Context, status int64, p *basic.PaginationOptions) ([]*Log, int64, error)
+	CountActivated(ctx context.Context, inviter string) (int64, error)
+	CountByDevice(ctx context.Context, device string) (int64, error)
+	CountByInviterIP(ctx context.Context, inviter, clientIP string) (int64, error)
+	CountByInviterSince(ctx context.Context, inviter string, since time.Time) (int64, error)
+	Activate(ctx context.Context, invitee string) (*Log, error)
+	MarkPaid(ctx context.Context, id primitive.ObjectID) error
+	FindUnpaid(ctx context.Context, before time.Time) ([]*Log, error)
+	Review(ctx context.Context, id, adminId, note string, to int64) (*Log, error)
+	FindAllByUser(ctx context.Context, userId string) ([]*Log, error)
+	Anonymize(ctx context.Context, userId string) error
 }
 
 type LogMongoMapper struct {
@@ -28,22 +52,49 @@ type LogMongoMapper struct {
 
 func NewLogMongoMapper(config *config.Config) *LogMongoMapper {
 	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, logCollectionName, config.Cache)
+	// 每个用户只能填写一次邀请码, 已有重复记录导致建立失败时不阻止启动, 仍由填写前的查询拦截重复填写
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "invitee", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		log.Error("invitation: create unique invitee index error %v", err)
+	}
 	return &LogMongoMapper{
 		conn: conn,
 	}
 }
 
-func (m *LogMongoMapper) Insert(ctx context.Context, inviter string, invitee string) error {
-	l := Log{
-		ID:        primitive.NewObjectID(),
-		Inviter:   inviter,
-		Invitee:   invitee,
-		Timestamp: time.Now(),
+// Insert 插入邀请记录, 被邀请者已填写过邀请码时返回consts.ErrRepeatInvitation
+func (m *LogMongoMapper) Insert(ctx context.Context, l *Log) error {
+	if l.ID.IsZero() {
+		l.ID = primitive.NewObjectID()
+		l.Timestamp = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, l)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrRepeatInvitation
 	}
-	_, err := m.conn.InsertOneNoCache(ctx, &l)
 	return err
 }
 
+func (m *LogMongoMapper) FindOne(ctx context.Context, id string) (*Log, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	l := &Log{}
+	err = m.conn.FindOneNoCache(ctx, l, bson.M{consts.ID: oid})
+	switch {
+	case err == nil:
+		return l, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 func (m *LogMongoMapper) FindOneByInvitee(ctx context.Context, invitee string) (*Log, error) {
 	l := &Log{}
 	err := m.conn.FindOneNoCache(ctx, l, bson.M{"invitee": invitee})
@@ -56,3 +107,137 @@ func (m *LogMongoMapper) FindOneByInvitee(ctx context.Context, invitee string) (
 		return nil, err
 	}
 }
+
+// FindManyByInviter 分页获取邀请者的邀请记录, 按填写时间倒序
+func (m *LogMongoMapper) FindManyByInviter(ctx context.Context, inviter string, p *basic.PaginationOptions) (ls []*Log, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	ls = make([]*Log, 0, limit)
+	err = m.conn.Find(ctx, &ls, bson.M{"inviter": inviter}, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.Timestamp, Value: -1}, {Key: consts.ID, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, bson.M{"inviter": inviter})
+	if err != nil {
+		return nil, 0, err
+	}
+	return ls, total, nil
+}
+
+// FindManyByStatus 分页获取指定状态的邀请记录, 按填写时间正序
+func (m *LogMongoMapper) FindManyByStatus(ctx context.Context, status int64, p *basic.PaginationOptions) (ls []*Log, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	ls = make([]*Log, 0, limit)
+	err = m.conn.Find(ctx, &ls, bson.M{consts.Status: status}, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.Timestamp, Value: 1}, {Key: consts.ID, Value: 1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, bson.M{consts.Status: status})
+	if err != nil {
+		return nil, 0, err
+	}
+	return ls, total, nil
+}
+
+// CountActivated 统计邀请者已激活的邀请人数
+func (m *LogMongoMapper) CountActivated(ctx context.Context, inviter string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{"inviter": inviter,
+		consts.Status: bson.M{"$nin": []int64{StatusPending, StatusReviewing, StatusRejected}}})
+}
+
+// CountByDevice 统计在该设备上填写过邀请码的人数
+func (m *LogMongoMapper) CountByDevice(ctx context.Context, device string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{deviceId: device})
+}
+
+// CountByInviterIP 统计邀请者来自该IP的被邀请者人数
+func (m *LogMongoMapper) CountByInviterIP(ctx context.Context, inviter, clientIP string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{"inviter": inviter, ip: clientIP})
+}
+
+// CountByInviterSince 统计邀请者在since之后的邀请人数
+func (m *LogMongoMapper) CountByInviterSince(ctx context.Context, inviter string, since time.Time) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{"inviter": inviter, consts.Timestamp: bson.M{"$gte": since}})
+}
+
+// Activate 将被邀请者待激活的邀请记录标记为已激活且奖励未发放, 没有待激活的记录时返回consts.ErrNotFound
+// 以状态为条件原子地修改, 保证并发时只有一次激活成功
+func (m *LogMongoMapper) Activate(ctx context.Context, invitee string) (*Log, error) {
+	l := &Log{}
+	err := m.conn.FindOneAndUpdateNoCache(ctx, l, bson.M{"invitee": invitee, consts.Status: StatusPending},
+		bson.M{"$set": bson.M{consts.Status: StatusActivated, activateTime: time.Now(), unpaid: true}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return l, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// MarkPaid 标记邀请者的奖励已发放完成
+func (m *LogMongoMapper) MarkPaid(ctx context.Context, id primitive.ObjectID) error {
+	_, err := m.conn.UpdateByIDNoCache(ctx, id, bson.M{"$unset": bson.M{unpaid: ""}})
+	return err
+}
+
+// FindUnpaid 获取before之前激活但奖励仍未发放完成的邀请记录, 用于补发奖励
+func (m *LogMongoMapper) FindUnpaid(ctx context.Context, before time.Time) ([]*Log, error) {
+	ls := make([]*Log, 0)
+	err := m.conn.Find(ctx, &ls, bson.M{unpaid: true, activateTime: bson.M{"$lt": before}})
+	if err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+// Review 审核待审核的邀请记录, 通过时to为StatusPending, 拒绝时为StatusRejected
+// 以状态为条件原子地修改, 已审核过的记录返回consts.ErrInvitationState
+func (m *LogMongoMapper) Review(ctx context.Context, id, adminId, note string, to int64) (*Log, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	l := &Log{}
+	err = m.conn.FindOneAndUpdateNoCache(ctx, l, bson.M{consts.ID: oid, consts.Status: StatusReviewing},
+		bson.M{"$set": bson.M{consts.Status: to, reviewer: adminId, reviewNote: note, reviewTime: time.Now()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return l, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrInvitationState
+	default:
+		return nil, err
+	}
+}
+
+// FindAllByUser 获取用户作为邀请者或被邀请者的所有邀请记录, 用于导出个人数据
+func (m *LogMongoMapper) FindAllByUser(ctx context.Context, userId string) ([]*Log, error) {
+	ls := make([]*Log, 0)
+	err := m.conn.Find(ctx, &ls, bson.M{"$or": bson.A{
+		bson.M{"inviter": userId},
+		bson.M{"invitee": userId},
+	}}, options.Find().SetSort(bson.M{consts.Timestamp: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return ls, nil
+}
+
+// Anonymize 清除用户作为被邀请者时记录的设备和IP, 邀请关系本身保留以便核对邀请者的奖励
+func (m *LogMongoMapper) Anonymize(ctx context.Context, userId string) error {
+	_, err := m.conn.UpdateManyNoCache(ctx, bson.M{"invitee": userId}, bson.M{
+		"$unset": bson.M{deviceId: "", ip: ""},
+	})
+	return err
+}
@@ -0,0 +1,74 @@
+package invitation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+	"golang.org/x/net/context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 同一被邀请者并发填写邀请码, 只记录一次邀请
+func TestLogInsertConcurrently(t *testing.T) {
+	m := NewLogMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	errs := make([]error, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = m.Insert(ctx, &Log{Inviter: fmt.Sprintf("inviter%d", i), Invitee: "invitee", Status: StatusPending})
+		}(i)
+	}
+	wg.Wait()
+
+	ok := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			ok++
+		case !errors.Is(err, consts.ErrRepeatInvitation):
+			t.Fatalf("Insert #%d error %v, want ErrRepeatInvitation", i, err)
+		}
+	}
+	if ok != 1 {
+		t.Fatalf("%d inserts succeeded, want 1", ok)
+	}
+	ls, err := m.FindAllByUser(ctx, "invitee")
+	if err != nil || len(ls) != 1 {
+		t.Fatalf("FindAllByUser(invitee) = (%d logs, %v), want 1", len(ls), err)
+	}
+}
+
+// 激活后奖励未发放完成的记录可被定时任务找到, 标记发放后不再出现
+func TestLogUnpaid(t *testing.T) {
+	m := NewLogMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	if err := m.Insert(ctx, &Log{Inviter: "inviter", Invitee: "invitee", Status: StatusPending}); err != nil {
+		t.Fatal(err)
+	}
+	l, err := m.Activate(ctx, "invitee")
+	if err != nil || !l.Unpaid {
+		t.Fatalf("Activate = (%v, %v), want unpaid log", l, err)
+	}
+	if _, err = m.Activate(ctx, "invitee"); !errors.Is(err, consts.ErrNotFound) {
+		t.Fatalf("Activate twice error %v, want ErrNotFound", err)
+	}
+
+	ls, err := m.FindUnpaid(ctx, time.Now().Add(time.Minute))
+	if err != nil || len(ls) != 1 || ls[0].ID != l.ID {
+		t.Fatalf("FindUnpaid = (%d logs, %v), want the activated log", len(ls), err)
+	}
+	if err = m.MarkPaid(ctx, l.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ls, err = m.FindUnpaid(ctx, time.Now().Add(time.Minute)); err != nil || len(ls) != 0 {
+		t.Fatalf("FindUnpaid after MarkPaid = (%d logs, %v), want none", len(ls), err)
+	}
+}
@@ -0,0 +1,38 @@
+package ledger
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// Entry 是一次批改次数的变动记录, 只追加不修改
+type Entry struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserId     string             `bson:"user_id" json:"userId"`
+	Delta      int64              `bson:"delta" json:"delta"`                      // 变动数量
+	Reason     string             `bson:"reason" json:"reason"`                    // 变动原因
+	RefId      string             `bson:"ref_id,omitempty" json:"refId,omitempty"` // 关联的业务id
+	Note       string             `bson:"note,omitempty" json:"note,omitempty"`    // 管理员调整时填写的原因
+	Balance    int64              `bson:"balance" json:"balance"`                  // 变动后的剩余次数
+	Opening    bool               `bson:"opening,omitempty" json:"-"`              // 是否为期初记录, 每个用户只有一条
+	Once       bool               `bson:"once,omitempty" json:"-"`                 // 是否为只生效一次的变动, 同一原因和关联id只有一条
+	CreateTime time.Time          `bson:"create_time" json:"createTime"`
+}
+
+// 变动原因
+const (
+	ReasonInit               = "init"                // 启用账本前已有的次数
+	ReasonSignUp             = "sign_up"             // 注册赠送
+	ReasonEvaluate           = "evaluate"            // 作文批改
+	ReasonRegenerateExercise = "regenerate_exercise" // 超出免费次数后重新生成练习
+	ReasonRegenerateFailed   = "regenerate_failed"   // 重新生成练习失败, 退回扣除的次数
+	ReasonAttend             = "attend"              // 每日签到及连续签到奖励
+	ReasonInvitation         = "invitation"          // 邀请奖励
+	ReasonAchievement        = "achievement"         // 徽章奖励
+	ReasonVoucher            = "voucher"             // 兑换码兑换
+	ReasonPurchase           = "purchase"            // 购买商品
+	ReasonRefund             = "refund"              // 订单退款
+	ReasonAudit              = "audit"               // 管理员按账本重建
+	ReasonAdmin              = "admin"               // 管理员手动调整
+	ReasonDeletion           = "deletion"            // 注销账号时清零
+)
@@ -0,0 +1,144 @@
+package ledger
+
+import (
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "quota_ledger"
+	opening        = "opening"
+	reason         = "reason"
+	refId          = "ref_id"
+	once           = "once"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, e *Entry) error
+	InsertOnce(ctx context.Context, e *Entry) error
+	Open(ctx context.Context, e *Entry) (bool, error)
+	HasOpening(ctx context.Context, userId string) (bool, error)
+	FindMany(ctx context.Context, userId string, p *basic.PaginationOptions) (es []*Entry, total int64, err error)
+	Count(ctx context.Context, userId string) (int64, error)
+	Sum(ctx context.Context, userId string) (int64, error)
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.UserID, Value: 1}, {Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 每个用户只有一条期初记录
+	_, err = conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: consts.UserID, Value: 1}, {Key: opening, Value: 1}},
+		Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{opening: true}),
+	})
+	if err != nil {
+		panic(err)
+	}
+	// 只生效一次的变动, 同一用户同一原因和关联id只记一条
+	_, err = conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: consts.UserID, Value: 1}, {Key: reason, Value: 1}, {Key: refId, Value: 1}},
+		Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{once: true}),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+func (m *MongoMapper) Insert(ctx context.Context, e *Entry) error {
+	if e.ID.IsZero() {
+		e.ID = primitive.NewObjectID()
+		e.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, e)
+	return err
+}
+
+// InsertOnce 插入只生效一次的变动记录, 已记过时忽略
+func (m *MongoMapper) InsertOnce(ctx context.Context, e *Entry) error {
+	e.Once = true
+	if err := m.Insert(ctx, e); err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
+
+// Open 写入用户的期初记录, 已有期初记录时不写入并返回false, 并发写入时只有一次成功
+func (m *MongoMapper) Open(ctx context.Context, e *Entry) (bool, error) {
+	e.ID = primitive.NewObjectID()
+	e.CreateTime = time.Now()
+	e.Opening = true
+	res, err := m.conn.UpdateOneNoCache(ctx, bson.M{consts.UserID: e.UserId, opening: true},
+		bson.M{"$setOnInsert": e}, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+// HasOpening 判断用户是否已有期初记录, 早期的期初记录没有opening标记, 按变动原因识别
+func (m *MongoMapper) HasOpening(ctx context.Context, userId string) (bool, error) {
+	n, err := m.conn.CountDocuments(ctx, bson.M{consts.UserID: userId, "$or": bson.A{
+		bson.M{opening: true},
+		bson.M{reason: bson.M{"$in": bson.A{ReasonInit, ReasonSignUp}}},
+	}})
+	return n > 0, err
+}
+
+// FindMany 分页获取用户的变动记录, 按时间倒序
+func (m *MongoMapper) FindMany(ctx context.Context, userId string, p *basic.PaginationOptions) (es []*Entry, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	es = make([]*Entry, 0, limit)
+	err = m.conn.Find(ctx, &es, bson.M{consts.UserID: userId}, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}, {Key: consts.ID, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, bson.M{consts.UserID: userId})
+	if err != nil {
+		return nil, 0, err
+	}
+	return es, total, nil
+}
+
+func (m *MongoMapper) Count(ctx context.Context, userId string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{consts.UserID: userId})
+}
+
+// Sum 按账本计算用户的剩余次数
+func (m *MongoMapper) Sum(ctx context.Context, userId string) (int64, error) {
+	var result []struct {
+		Sum int64 `bson:"sum"`
+	}
+	err := m.conn.Aggregate(ctx, &result, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{consts.UserID: userId}}},
+		{{Key: "$group", Value: bson.M{"_id": nil, "sum": bson.M{"$sum": "$delta"}}}},
+	})
+	if err != nil || len(result) == 0 {
+		return 0, err
+	}
+	return result[0].Sum, nil
+}
@@ -1,10 +1,20 @@
 package log
 
 import (
+	"encoding/json"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/analysis"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"strings"
 	"time"
 )
 
+// 批改结果中总分、各维度得分和问题列表所在的路径
+const (
+	scorePath     = "data.score"
+	dimensionPath = "data.dimensions"
+	issuePath     = "data.issues"
+)
+
 type Log struct {
 	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
 	UserId     string             `bson:"user_id" json:"user_id"`
@@ -13,5 +23,63 @@ type Log struct {
 	Response   string             `bson:"response" json:"response"`
 	Like       int64              `bson:"like" json:"like"`
 	Status     int                `bson:"status" json:"status"`
+	Assignment string             `bson:"assignment_id,omitempty" json:"assignmentId,omitempty"` // 提交的班级作业
+	Prompt     string             `bson:"prompt_id,omitempty" json:"promptId,omitempty"`         // 选择的题库题目
+	WordCount  int64              `bson:"word_count,omitempty" json:"wordCount,omitempty"`
+	Analysis   *analysis.Analysis `bson:"analysis,omitempty" json:"analysis,omitempty"` // 批改前的本地文本分析
 	CreateTime time.Time          `bson:"create_time,omitempty" json:"createTime"`
 }
+
+// Score 从批改结果中解析总分, 解析失败时返回false
+func (l *Log) Score() (int64, bool) {
+	score, ok := l.lookup(scorePath).(float64)
+	return int64(score), ok
+}
+
+// Dimensions 从批改结果中解析各维度的得分, 得分可以是数字或带有score字段的对象
+func (l *Log) Dimensions() map[string]int64 {
+	vs, _ := l.lookup(dimensionPath).(map[string]any)
+	ds := make(map[string]int64, len(vs))
+	for k, v := range vs {
+		if o, ok := v.(map[string]any); ok {
+			v = o["score"]
+		}
+		if score, ok := v.(float64); ok {
+			ds[k] = int64(score)
+		}
+	}
+	return ds
+}
+
+// Issues 从批改结果中解析指出的问题, 问题可以是字符串或带有type字段的对象
+func (l *Log) Issues() []string {
+	vs, _ := l.lookup(issuePath).([]any)
+	issues := make([]string, 0, len(vs))
+	for _, v := range vs {
+		switch i := v.(type) {
+		case string:
+			issues = append(issues, i)
+		case map[string]any:
+			if t, ok := i["type"].(string); ok {
+				issues = append(issues, t)
+			}
+		}
+	}
+	return issues
+}
+
+// lookup 获取批改结果中path处的值, 不存在时返回nil
+func (l *Log) lookup(path string) any {
+	var v any
+	if err := json.Unmarshal([]byte(l.Response), &v); err != nil {
+		return nil
+	}
+	for _, k := range strings.Split(path, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = m[k]
+	}
+	return v
+}
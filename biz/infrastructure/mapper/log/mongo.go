@@ -19,6 +19,8 @@ const (
 	prefixKeyCacheKey = "cache:log"
 	CollectionName    = "log"
 	ErrCollectionName = "err_log"
+	assignmentId      = "assignment_id"
+	exerciseCount     = "exercise_count" // 生成过的练习数, 不在Log结构中, 避免Update整体写回时覆盖
 )
 
 type IMongoMapper interface {
@@ -27,6 +29,13 @@ type IMongoMapper interface {
 	FindMany(ctx context.Context, userId string, p *basic.PaginationOptions) (logs []*Log, total int64, err error)
 	FindOne(ctx context.Context, id string) (l *Log, err error)
 	Update(ctx context.Context, l *Log) error
+	Count(ctx context.Context, userId string) (int64, error)
+	FindAllByUserId(ctx context.Context, userId string) (logs []*Log, err error)
+	DeleteByUserId(ctx context.Context, userId string) error
+	FindManyByAssignment(ctx context.Context, assignment string) (logs []*Log, err error)
+	FindManyByUserAndAssignments(ctx context.Context, userId string, assignments []string) (logs []*Log, err error)
+	ReserveExercise(ctx context.Context, id string, existing int64) (int64, error)
+	ReleaseExercise(ctx context.Context, id string) error
 }
 
 type MongoMapper struct {
@@ -37,6 +46,13 @@ type MongoMapper struct {
 func NewMongoMapper(config *config.Config) *MongoMapper {
 	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
 	errConn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, ErrCollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: assignmentId, Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		panic(err)
+	}
 	return &MongoMapper{conn: conn, errConn: errConn}
 }
 
@@ -112,3 +128,80 @@ func (m *MongoMapper) Update(ctx context.Context, l *Log) error {
 	_, err := m.conn.UpdateByID(ctx, key, l.ID, bson.M{"$set": l})
 	return err
 }
+
+// Count 统计用户成功的批改次数
+func (m *MongoMapper) Count(ctx context.Context, userId string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{consts.UserID: userId})
+}
+
+// FindAllByUserId 获取用户所有的批改记录, 用于导出个人数据
+func (m *MongoMapper) FindAllByUserId(ctx context.Context, userId string) (logs []*Log, err error) {
+	logs = make([]*Log, 0)
+	err = m.conn.Find(ctx, &logs, bson.M{consts.UserID: userId}, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// DeleteByUserId 删除用户所有的批改记录, 包括批改失败的记录
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	if _, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId}); err != nil {
+		return err
+	}
+	_, err := m.errConn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
+
+// FindManyByAssignment 获取提交到某个作业的所有批改记录, 按提交时间正序
+func (m *MongoMapper) FindManyByAssignment(ctx context.Context, assignment string) (logs []*Log, err error) {
+	logs = make([]*Log, 0)
+	err = m.conn.Find(ctx, &logs, bson.M{assignmentId: assignment}, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// FindManyByUserAndAssignments 获取用户提交到这些作业的批改记录, 按提交时间正序
+func (m *MongoMapper) FindManyByUserAndAssignments(ctx context.Context, userId string, assignments []string) (logs []*Log, err error) {
+	logs = make([]*Log, 0)
+	err = m.conn.Find(ctx, &logs, bson.M{consts.UserID: userId, assignmentId: bson.M{"$in": assignments}}, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// ReserveExercise 原子地为批改记录预占一次练习生成, 返回包括本次在内的生成次数
+// 计数启用前已生成的练习数由existing补齐
+func (m *MongoMapper) ReserveExercise(ctx context.Context, id string, existing int64) (int64, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return 0, consts.ErrInvalidObjectId
+	}
+	var r struct {
+		Count int64 `bson:"exercise_count"`
+	}
+	err = m.conn.FindOneAndUpdateNoCache(ctx, &r, bson.M{consts.ID: oid}, mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{exerciseCount: bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$" + exerciseCount, existing}}, 1}}}}},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return r.Count, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return 0, consts.ErrNotFound
+	default:
+		return 0, err
+	}
+}
+
+// ReleaseExercise 生成失败时退回预占的一次生成
+func (m *MongoMapper) ReleaseExercise(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	_, err = m.conn.UpdateByIDNoCache(ctx, oid, bson.M{"$inc": bson.M{exerciseCount: -1}})
+	return err
+}
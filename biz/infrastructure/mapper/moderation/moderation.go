@@ -0,0 +1,34 @@
+package moderation
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// Record 是一次被拒绝或需要人工关注的提交, 保存原文供管理员复核
+type Record struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserId     string             `bson:"user_id" json:"userId"`
+	Verdict    string             `bson:"verdict" json:"verdict"` // 审核结论, 见moderation.VerdictBlock等
+	Reasons    []string           `bson:"reasons" json:"reasons"`
+	Hits       []string           `bson:"hits" json:"hits"` // 命中的词
+	Title      string             `bson:"title" json:"title"`
+	Text       string             `bson:"text" json:"text"`
+	Status     int64              `bson:"status" json:"status"`
+	ReviewerId string             `bson:"reviewer_id,omitempty" json:"reviewerId,omitempty"`
+	Note       string             `bson:"note,omitempty" json:"note,omitempty"` // 复核备注
+	CreateTime time.Time          `bson:"create_time" json:"createTime"`
+	UpdateTime time.Time          `bson:"update_time" json:"updateTime"`
+}
+
+// 复核状态
+const (
+	StatusPending  = 0 // 待复核
+	StatusResolved = 1 // 已复核
+)
+
+// Filter 是管理员筛选记录的条件, 零值表示不限
+type Filter struct {
+	Verdict string
+	Status  *int64
+}
@@ -0,0 +1,125 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	CollectionName = "moderation"
+	verdict        = "verdict"
+	updateTime     = "update_time"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, r *Record) error
+	FindOne(ctx context.Context, id string) (*Record, error)
+	FindMany(ctx context.Context, filter *Filter, p *basic.PaginationOptions) (rs []*Record, total int64, err error)
+	Resolve(ctx context.Context, id, reviewerId, note string) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.Status, Value: 1}, {Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+func (m *MongoMapper) Insert(ctx context.Context, r *Record) error {
+	if r.ID.IsZero() {
+		r.ID = primitive.NewObjectID()
+		r.CreateTime = time.Now()
+		r.UpdateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, r)
+	return err
+}
+
+func (m *MongoMapper) FindOne(ctx context.Context, id string) (*Record, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	var r Record
+	err = m.conn.FindOneNoCache(ctx, &r, bson.M{consts.ID: oid})
+	switch {
+	case err == nil:
+		return &r, nil
+	case errors.Is(err, monc.ErrNotFound):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindMany 按条件分页获取记录, 按提交时间倒序
+func (m *MongoMapper) FindMany(ctx context.Context, filter *Filter, p *basic.PaginationOptions) (rs []*Record, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	f := bson.M{}
+	if filter.Verdict != "" {
+		f[verdict] = filter.Verdict
+	}
+	if filter.Status != nil {
+		f[consts.Status] = *filter.Status
+	}
+	rs = make([]*Record, 0, limit)
+	err = m.conn.Find(ctx, &rs, f, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	return rs, total, nil
+}
+
+// Resolve 标记记录已复核
+func (m *MongoMapper) Resolve(ctx context.Context, id, reviewerId, note string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	res, err := m.conn.UpdateByIDNoCache(ctx, oid, bson.M{"$set": bson.M{
+		consts.Status: StatusResolved,
+		"reviewer_id": reviewerId,
+		"note":        note,
+		updateTime:    time.Now(),
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByUserId 删除用户的所有审核记录
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
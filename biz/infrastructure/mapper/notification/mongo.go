@@ -0,0 +1,102 @@
+package notification
+
+import (
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "notification"
+	read           = "read"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, n *Notification) error
+	FindMany(ctx context.Context, userId string, p *basic.PaginationOptions) (ns []*Notification, total int64, err error)
+	CountUnread(ctx context.Context, userId string) (int64, error)
+	MarkRead(ctx context.Context, userId string, ids []string) error
+	MarkAllRead(ctx context.Context, userId string) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.UserID, Value: 1}, {Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+func (m *MongoMapper) Insert(ctx context.Context, n *Notification) error {
+	if n.ID.IsZero() {
+		n.ID = primitive.NewObjectID()
+		n.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, n)
+	return err
+}
+
+// FindMany 分页获取用户的通知, 按时间倒序
+func (m *MongoMapper) FindMany(ctx context.Context, userId string, p *basic.PaginationOptions) (ns []*Notification, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	ns = make([]*Notification, 0, limit)
+	err = m.conn.Find(ctx, &ns, bson.M{consts.UserID: userId}, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}, {Key: consts.ID, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, bson.M{consts.UserID: userId})
+	if err != nil {
+		return nil, 0, err
+	}
+	return ns, total, nil
+}
+
+func (m *MongoMapper) CountUnread(ctx context.Context, userId string) (int64, error) {
+	return m.conn.CountDocuments(ctx, bson.M{consts.UserID: userId, read: false})
+}
+
+// MarkRead 将用户的指定通知标记为已读, 忽略不属于该用户的通知
+func (m *MongoMapper) MarkRead(ctx context.Context, userId string, ids []string) error {
+	oids := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		oid, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return consts.ErrInvalidObjectId
+		}
+		oids = append(oids, oid)
+	}
+	_, err := m.conn.UpdateManyNoCache(ctx, bson.M{consts.ID: bson.M{"$in": oids}, consts.UserID: userId, read: false},
+		bson.M{"$set": bson.M{read: true}})
+	return err
+}
+
+func (m *MongoMapper) MarkAllRead(ctx context.Context, userId string) error {
+	_, err := m.conn.UpdateManyNoCache(ctx, bson.M{consts.UserID: userId, read: false}, bson.M{"$set": bson.M{read: true}})
+	return err
+}
+
+// DeleteByUserId 删除用户的所有通知
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
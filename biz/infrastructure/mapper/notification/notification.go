@@ -0,0 +1,30 @@
+package notification
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// Notification 是用户收件箱中的一条站内通知
+type Notification struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserId     string             `bson:"user_id" json:"userId"`
+	Type       string             `bson:"type" json:"type"`
+	Title      string             `bson:"title" json:"title"`
+	Content    string             `bson:"content" json:"content"`
+	RefId      string             `bson:"ref_id,omitempty" json:"refId"` // 关联的业务id
+	Read       bool               `bson:"read" json:"read"`
+	CreateTime time.Time          `bson:"create_time" json:"createTime"`
+}
+
+// 通知类型
+const (
+	TypeFeedbackReplied = "feedback_replied" // 反馈收到回复
+	TypeQuotaGranted    = "quota_granted"    // 获得批改次数或套餐
+	TypeAchievement     = "achievement"      // 获得徽章
+	TypeInvitation      = "invitation"       // 邀请的用户完成首次批改
+	TypeStreakReminder  = "streak_reminder"  // 连续签到即将中断
+	TypeRelation        = "relation"         // 家长绑定了自己的账号
+	TypeReview          = "review"           // 教师批阅了自己的作文
+	TypeModeration      = "moderation"       // 有需要人工关注的提交, 发送给管理员
+)
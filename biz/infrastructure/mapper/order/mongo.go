@@ -0,0 +1,150 @@
+package order
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "order"
+	updateTime     = "update_time"
+	transactionId  = "transaction_id"
+	refundReason   = "refund_reason"
+	payTime        = "pay_time"
+	refundTime     = "refund_time"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, o *Order) error
+	FindOne(ctx context.Context, id string) (*Order, error)
+	FindMany(ctx context.Context, userId string, p *basic.PaginationOptions) (os []*Order, total int64, err error)
+	Pay(ctx context.Context, id, transaction string, t time.Time) (*Order, error)
+	Fulfill(ctx context.Context, id string) error
+	Refund(ctx context.Context, id, reason string, t time.Time) (*Order, error)
+	Revert(ctx context.Context, id string, from, to int64) error
+	FindStuck(ctx context.Context, before time.Time) ([]*Order, error)
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.UserID, Value: 1}, {Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+func (m *MongoMapper) Insert(ctx context.Context, o *Order) error {
+	if o.ID.IsZero() {
+		o.ID = primitive.NewObjectID()
+		o.CreateTime = time.Now()
+		o.UpdateTime = o.CreateTime
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, o)
+	return err
+}
+
+func (m *MongoMapper) FindOne(ctx context.Context, id string) (*Order, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	o := &Order{}
+	err = m.conn.FindOneNoCache(ctx, o, bson.M{consts.ID: oid})
+	switch {
+	case err == nil:
+		return o, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindMany 分页获取用户的订单, 按时间倒序
+func (m *MongoMapper) FindMany(ctx context.Context, userId string, p *basic.PaginationOptions) (os []*Order, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	os = make([]*Order, 0, limit)
+	err = m.conn.Find(ctx, &os, bson.M{consts.UserID: userId}, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}, {Key: consts.ID, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, bson.M{consts.UserID: userId})
+	if err != nil {
+		return nil, 0, err
+	}
+	return os, total, nil
+}
+
+// Pay 将待支付的订单标记为已支付, 重复的回调会因状态不符返回consts.ErrOrderStatus
+func (m *MongoMapper) Pay(ctx context.Context, id, transaction string, t time.Time) (*Order, error) {
+	return m.transit(ctx, id, StatusPending, StatusPaid, bson.M{transactionId: transaction, payTime: t})
+}
+
+// Fulfill 将已支付的订单标记为已到账
+func (m *MongoMapper) Fulfill(ctx context.Context, id string) error {
+	_, err := m.transit(ctx, id, StatusPaid, StatusFulfilled, bson.M{})
+	return err
+}
+
+// Refund 将已到账的订单标记为已退款
+func (m *MongoMapper) Refund(ctx context.Context, id, reason string, t time.Time) (*Order, error) {
+	return m.transit(ctx, id, StatusFulfilled, StatusRefunded, bson.M{refundReason: reason, refundTime: t})
+}
+
+// Revert 后续步骤失败时将订单状态从from退回to
+func (m *MongoMapper) Revert(ctx context.Context, id string, from, to int64) error {
+	_, err := m.transit(ctx, id, from, to, bson.M{})
+	return err
+}
+
+// FindStuck 获取before之前支付但仍未到账的订单, 用于补发权益
+func (m *MongoMapper) FindStuck(ctx context.Context, before time.Time) ([]*Order, error) {
+	os := make([]*Order, 0)
+	err := m.conn.Find(ctx, &os, bson.M{consts.Status: StatusPaid, updateTime: bson.M{"$lt": before}})
+	if err != nil {
+		return nil, err
+	}
+	return os, nil
+}
+
+// transit 以当前状态为条件原子地修改订单状态, 保证并发时只有一次修改成功
+func (m *MongoMapper) transit(ctx context.Context, id string, from, to int64, set bson.M) (*Order, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	set[consts.Status] = to
+	set[updateTime] = time.Now()
+	o := &Order{}
+	err = m.conn.FindOneAndUpdateNoCache(ctx, o, bson.M{consts.ID: oid, consts.Status: from}, bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return o, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrOrderStatus
+	default:
+		return nil, err
+	}
+}
@@ -0,0 +1,31 @@
+package order
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// 订单状态, 只能按 待支付 -> 已支付 -> 已到账 -> 已退款 的顺序流转
+const (
+	StatusPending   int64 = 0 // 待支付
+	StatusPaid      int64 = 1 // 已支付, 权益尚未到账
+	StatusFulfilled int64 = 2 // 已到账
+	StatusRefunded  int64 = 3 // 已退款
+)
+
+type Order struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserId        string             `bson:"user_id" json:"userId"`
+	ProductId     string             `bson:"product_id" json:"productId"`
+	Name          string             `bson:"name" json:"name"`
+	Amount        int64              `bson:"amount" json:"amount"` // 金额, 单位分
+	Credits       int64              `bson:"credits,omitempty" json:"credits,omitempty"`
+	PlanId        string             `bson:"plan_id,omitempty" json:"planId,omitempty"`
+	Status        int64              `bson:"status" json:"status"`
+	TransactionId string             `bson:"transaction_id,omitempty" json:"transactionId,omitempty"` // 网关侧的交易号
+	RefundReason  string             `bson:"refund_reason,omitempty" json:"refundReason,omitempty"`
+	CreateTime    time.Time          `bson:"create_time" json:"createTime"`
+	UpdateTime    time.Time          `bson:"update_time" json:"updateTime"`
+	PayTime       time.Time          `bson:"pay_time,omitempty" json:"payTime,omitempty"`
+	RefundTime    time.Time          `bson:"refund_time,omitempty" json:"refundTime,omitempty"`
+}
@@ -0,0 +1,137 @@
+package prompt
+
+import (
+	"context"
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+const (
+	CollectionName = "prompt"
+	grade          = "grade"
+	essayType      = "essay_type"
+	updateTime     = "update_time"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, p *Prompt) error
+	FindOne(ctx context.Context, id string) (*Prompt, error)
+	FindMany(ctx context.Context, filter *Filter, p *basic.PaginationOptions) (ps []*Prompt, total int64, err error)
+	Update(ctx context.Context, p *Prompt) error
+	UpdateStatus(ctx context.Context, id string, status int64) error
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: grade, Value: 1}, {Key: essayType, Value: 1}, {Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+func (m *MongoMapper) Insert(ctx context.Context, p *Prompt) error {
+	if p.ID.IsZero() {
+		p.ID = primitive.NewObjectID()
+		p.CreateTime = time.Now()
+		p.UpdateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, p)
+	return err
+}
+
+func (m *MongoMapper) FindOne(ctx context.Context, id string) (*Prompt, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, consts.ErrInvalidObjectId
+	}
+	var p Prompt
+	err = m.conn.FindOneNoCache(ctx, &p, bson.M{consts.ID: oid})
+	switch {
+	case err == nil:
+		return &p, nil
+	case errors.Is(err, monc.ErrNotFound):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindMany 按条件分页获取题目, 按创建时间倒序
+func (m *MongoMapper) FindMany(ctx context.Context, filter *Filter, p *basic.PaginationOptions) (ps []*Prompt, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	f := bson.M{}
+	if filter.Grade != 0 {
+		f[grade] = filter.Grade
+	}
+	if filter.EssayType != "" {
+		f[essayType] = filter.EssayType
+	}
+	if filter.Status != nil {
+		f[consts.Status] = *filter.Status
+	}
+	ps = make([]*Prompt, 0, limit)
+	err = m.conn.Find(ctx, &ps, f, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, 0, err
+	}
+	return ps, total, nil
+}
+
+// Update 更新题目的内容, 不修改状态和创建时间
+func (m *MongoMapper) Update(ctx context.Context, p *Prompt) error {
+	p.UpdateTime = time.Now()
+	return m.update(ctx, p.ID, bson.M{"$set": bson.M{
+		"title":       p.Title,
+		"instruction": p.Instruction,
+		grade:         p.Grade,
+		essayType:     p.EssayType,
+		"min_words":   p.MinWords,
+		"max_words":   p.MaxWords,
+		"models":      p.Models,
+		updateTime:    p.UpdateTime,
+	}})
+}
+
+// UpdateStatus 上架或下架题目
+func (m *MongoMapper) UpdateStatus(ctx context.Context, id string, status int64) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	return m.update(ctx, oid, bson.M{"$set": bson.M{consts.Status: status, updateTime: time.Now()}})
+}
+
+func (m *MongoMapper) update(ctx context.Context, oid primitive.ObjectID, update bson.M) error {
+	res, err := m.conn.UpdateByIDNoCache(ctx, oid, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package prompt
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type (
+	// Prompt 是题库中的一道作文题, 学生可以选择题目开始批改
+	Prompt struct {
+		ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		Title       string             `bson:"title" json:"title"`
+		Instruction string             `bson:"instruction" json:"instruction"` // 写作要求
+		Grade       int64              `bson:"grade" json:"grade"`
+		EssayType   string             `bson:"essay_type" json:"essayType"`
+		MinWords    int64              `bson:"min_words" json:"minWords"` // 字数下限, 0表示不限
+		MaxWords    int64              `bson:"max_words" json:"maxWords"` // 字数上限, 0表示不限
+		Models      []*Model           `bson:"models" json:"models"`
+		Status      int64              `bson:"status" json:"status"`
+		CreateTime  time.Time          `bson:"create_time" json:"createTime"`
+		UpdateTime  time.Time          `bson:"update_time" json:"updateTime"`
+	}
+
+	// Model 是题目的一篇范文及点评
+	Model struct {
+		Title      string `bson:"title" json:"title"`
+		Text       string `bson:"text" json:"text"`
+		Commentary string `bson:"commentary" json:"commentary"`
+	}
+)
+
+// 题目状态, 下架的题目不再展示, 但已有的批改记录仍然可以关联
+const (
+	StatusOnline  = 0
+	StatusOffline = 1
+)
+
+// Filter 是浏览题目的条件, 零值表示不限
+type Filter struct {
+	Grade     int64
+	EssayType string
+	Status    *int64
+}
+
+// CheckWords 判断字数是否满足题目要求
+func (p *Prompt) CheckWords(n int64) bool {
+	return (p.MinWords == 0 || n >= p.MinWords) && (p.MaxWords == 0 || n <= p.MaxWords)
+}
@@ -0,0 +1,63 @@
+package rank
+
+import (
+	"fmt"
+	"time"
+)
+
+// 榜单类型
+const (
+	BoardExercise = "exercise" // 练习得分榜
+	BoardAttend   = "attend"   // 连续签到榜
+)
+
+// 统计周期
+const (
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+// 排行范围
+const (
+	ScopeSchool = "school"
+	ScopeGrade  = "grade"
+)
+
+var (
+	Boards  = []string{BoardExercise, BoardAttend}
+	Periods = []string{PeriodWeek, PeriodMonth}
+	Scopes  = []string{ScopeSchool, ScopeGrade}
+)
+
+// Item 是榜单中的一项, Member为用户ID
+type Item struct {
+	Member string
+	Score  int64
+	Rank   int64 // 从1开始
+}
+
+// Key 构造榜单的键, 如 rank:exercise:week:2024W09:school:某某中学
+func Key(board, period string, t time.Time, scope, value string) string {
+	return fmt.Sprintf("rank:%s:%s:%s:%s:%s", board, period, PeriodKey(period, t), scope, value)
+}
+
+// PeriodKey 返回t所在统计周期的标识
+func PeriodKey(period string, t time.Time) string {
+	if period == PeriodWeek {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%dW%02d", year, week)
+	}
+	return t.Format("200601")
+}
+
+// PeriodRange 返回t所在统计周期的起止时间, 周以周一为第一天
+func PeriodRange(period string, t time.Time) (start, end time.Time) {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	if period == PeriodWeek {
+		offset := (int(day.Weekday()) + 6) % 7
+		start = day.AddDate(0, 0, -offset)
+		return start, start.AddDate(0, 0, 7)
+	}
+	start = day.AddDate(0, 0, 1-day.Day())
+	return start, start.AddDate(0, 1, 0)
+}
@@ -0,0 +1,135 @@
+package rank
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/redis"
+	rds "github.com/zeromicro/go-zero/core/stores/redis"
+	"golang.org/x/net/context"
+	"strings"
+	"time"
+)
+
+// ttl 榜单保留时长, 超过一个统计周期后自动过期
+const ttl = 40 * 24 * time.Hour
+
+type IRedisMapper interface {
+	Incr(ctx context.Context, key, member string, delta int64) error
+	SetMax(ctx context.Context, key, member string, score int64) error
+	Replace(ctx context.Context, key string, items map[string]int64) error
+	Remove(ctx context.Context, member string, keys ...string) error
+	Top(ctx context.Context, key string, n int64) ([]*Item, error)
+	Find(ctx context.Context, key, member string) (*Item, error)
+	Keys(ctx context.Context, prefix string) ([]string, error)
+}
+
+// globEscaper 转义SCAN匹配模式中的特殊字符, 学校名称等用户输入可能包含这些字符
+var globEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+// RedisMapper 用redis有序集合存储排行榜
+type RedisMapper struct {
+	rds *rds.Redis
+}
+
+func NewRedisMapper(config *config.Config) *RedisMapper {
+	return &RedisMapper{rds: redis.GetRedis(config)}
+}
+
+// Incr 增加成员的分数
+func (m *RedisMapper) Incr(ctx context.Context, key, member string, delta int64) error {
+	if _, err := m.rds.ZincrbyCtx(ctx, key, delta, member); err != nil {
+		return err
+	}
+	return m.rds.ExpireCtx(ctx, key, int(ttl.Seconds()))
+}
+
+// SetMax 仅当新分数更高时更新成员的分数
+func (m *RedisMapper) SetMax(ctx context.Context, key, member string, score int64) error {
+	old, err := m.rds.ZscoreCtx(ctx, key, member)
+	if err != nil && !errors.Is(err, rds.Nil) {
+		return err
+	}
+	if err == nil && old >= score {
+		return nil
+	}
+	if _, err = m.rds.ZaddCtx(ctx, key, score, member); err != nil {
+		return err
+	}
+	return m.rds.ExpireCtx(ctx, key, int(ttl.Seconds()))
+}
+
+// Replace 用items整体替换榜单, 先写入临时键再重命名, 避免重建过程中读到不完整的榜单
+func (m *RedisMapper) Replace(ctx context.Context, key string, items map[string]int64) error {
+	if len(items) == 0 {
+		_, err := m.rds.DelCtx(ctx, key)
+		return err
+	}
+	tmp := key + ":tmp"
+	return m.rds.PipelinedCtx(ctx, func(p rds.Pipeliner) error {
+		p.Del(ctx, tmp)
+		for member, score := range items {
+			p.ZAdd(ctx, tmp, rds.Z{Score: float64(score), Member: member})
+		}
+		p.Rename(ctx, tmp, key)
+		p.Expire(ctx, key, ttl)
+		return nil
+	})
+}
+
+// Remove 从多个榜单中移除成员
+func (m *RedisMapper) Remove(ctx context.Context, member string, keys ...string) error {
+	for _, key := range keys {
+		if _, err := m.rds.ZremCtx(ctx, key, member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Top 获取榜单前n名
+func (m *RedisMapper) Top(ctx context.Context, key string, n int64) ([]*Item, error) {
+	pairs, err := m.rds.ZrevrangeWithScoresCtx(ctx, key, 0, n-1)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*Item, 0, len(pairs))
+	for i, p := range pairs {
+		items = append(items, &Item{Member: p.Key, Score: p.Score, Rank: int64(i) + 1})
+	}
+	return items, nil
+}
+
+// Find 获取成员在榜单中的排名, 未上榜时返回consts.ErrNotFound
+func (m *RedisMapper) Find(ctx context.Context, key, member string) (*Item, error) {
+	r, err := m.rds.ZrevrankCtx(ctx, key, member)
+	if errors.Is(err, rds.Nil) {
+		return nil, consts.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	score, err := m.rds.ZscoreCtx(ctx, key, member)
+	if err != nil {
+		return nil, err
+	}
+	return &Item{Member: member, Score: score, Rank: r + 1}, nil
+}
+
+// Keys 获取以prefix开头的所有榜单键, prefix中的通配符按原样匹配
+func (m *RedisMapper) Keys(ctx context.Context, prefix string) ([]string, error) {
+	var (
+		cursor uint64
+		keys   []string
+	)
+	pattern := globEscaper.Replace(prefix) + "*"
+	for {
+		ks, next, err := m.rds.ScanCtx(ctx, cursor, pattern, 100)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, ks...)
+		if cursor = next; cursor == 0 {
+			return keys, nil
+		}
+	}
+}
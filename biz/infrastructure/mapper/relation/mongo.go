@@ -0,0 +1,119 @@
+package relation
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "relation"
+	parentId       = "parent_id"
+	studentId      = "student_id"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, r *Relation) error
+	FindOne(ctx context.Context, parent, student string) (*Relation, error)
+	FindManyByParent(ctx context.Context, parent string) ([]*Relation, error)
+	FindManyByStudent(ctx context.Context, student string) ([]*Relation, error)
+	Delete(ctx context.Context, parent, student string) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	// 同一对家长和学生只能绑定一次
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: parentId, Value: 1}, {Key: studentId, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	_, err = conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: studentId, Value: 1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+// Insert 插入绑定关系, 已绑定时返回consts.ErrRelationExists
+func (m *MongoMapper) Insert(ctx context.Context, r *Relation) error {
+	if r.ID.IsZero() {
+		r.ID = primitive.NewObjectID()
+		r.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, r)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrRelationExists
+	}
+	return err
+}
+
+// FindOne 查询家长与学生的绑定关系, 未绑定时返回consts.ErrNotFound
+func (m *MongoMapper) FindOne(ctx context.Context, parent, student string) (*Relation, error) {
+	var r Relation
+	err := m.conn.FindOneNoCache(ctx, &r, bson.M{parentId: parent, studentId: student})
+	switch {
+	case err == nil:
+		return &r, nil
+	case errors.Is(err, monc.ErrNotFound):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindManyByParent 获取家长绑定的所有学生, 按绑定时间正序
+func (m *MongoMapper) FindManyByParent(ctx context.Context, parent string) ([]*Relation, error) {
+	return m.find(ctx, bson.M{parentId: parent})
+}
+
+// FindManyByStudent 获取学生绑定的所有家长, 按绑定时间正序
+func (m *MongoMapper) FindManyByStudent(ctx context.Context, student string) ([]*Relation, error) {
+	return m.find(ctx, bson.M{studentId: student})
+}
+
+// Delete 解除绑定, 未绑定时返回consts.ErrNotFound
+func (m *MongoMapper) Delete(ctx context.Context, parent, student string) error {
+	n, err := m.conn.DeleteMany(ctx, bson.M{parentId: parent, studentId: student})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
+
+// DeleteByUserId 删除用户作为家长或学生的所有绑定关系
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{"$or": bson.A{
+		bson.M{parentId: userId},
+		bson.M{studentId: userId},
+	}})
+	return err
+}
+
+func (m *MongoMapper) find(ctx context.Context, filter bson.M) ([]*Relation, error) {
+	rs := make([]*Relation, 0)
+	err := m.conn.Find(ctx, &rs, filter, options.Find().SetSort(bson.M{consts.CreateTime: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
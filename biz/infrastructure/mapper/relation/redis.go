@@ -0,0 +1,98 @@
+package relation
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/redis"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	rds "github.com/zeromicro/go-zero/core/stores/redis"
+	"golang.org/x/net/context"
+	"strconv"
+)
+
+const (
+	prefixCodeKey = "relation:code:"
+	prefixFailKey = "relation:fail:"
+	alphabet      = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // 去掉了容易混淆的I、O、0、1
+	codeLength    = 6
+	maxRetry      = 10
+)
+
+type ICodeRedisMapper interface {
+	Insert(ctx context.Context, student string) (string, error)
+	Take(ctx context.Context, user, code string) (string, error)
+}
+
+// CodeRedisMapper 保存学生生成的绑定码, 绑定码在有效期内只能被使用一次
+type CodeRedisMapper struct {
+	rds         *rds.Redis
+	expire      int
+	maxFailures int64
+	failPeriod  int
+}
+
+func NewCodeRedisMapper(config *config.Config) *CodeRedisMapper {
+	c := config.Relation
+	return &CodeRedisMapper{rds: redis.GetRedis(config), expire: int(c.CodeExpire), maxFailures: c.MaxFailures, failPeriod: int(c.FailPeriod)}
+}
+
+// Insert 为学生生成一个新的绑定码, 与未过期的绑定码冲突时重新生成
+func (m *CodeRedisMapper) Insert(ctx context.Context, student string) (string, error) {
+	for i := 0; i < maxRetry; i++ {
+		code, err := util.RandomCode(alphabet, codeLength)
+		if err != nil {
+			return "", err
+		}
+		ok, err := m.rds.SetnxExCtx(ctx, prefixCodeKey+code, student, m.expire)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return code, nil
+		}
+	}
+	return "", consts.ErrLinkCode
+}
+
+// Take 用户使用绑定码并返回生成它的学生id, 绑定码无效或已被使用时返回consts.ErrLinkCode
+// 用户在一个周期内填错过多时返回consts.ErrTooManyAttempts, 防止暴力猜测绑定码
+func (m *CodeRedisMapper) Take(ctx context.Context, user, code string) (string, error) {
+	failures, err := m.rds.GetCtx(ctx, prefixFailKey+user)
+	if err != nil {
+		return "", err
+	}
+	if n, _ := strconv.ParseInt(failures, 10, 64); n >= m.maxFailures {
+		return "", consts.ErrTooManyAttempts
+	}
+
+	student, err := m.rds.GetCtx(ctx, prefixCodeKey+code)
+	if err != nil {
+		return "", err
+	}
+	if student == "" {
+		return "", m.fail(ctx, user)
+	}
+	// 并发使用同一绑定码时只有删除成功的一方有效
+	n, err := m.rds.DelCtx(ctx, prefixCodeKey+code)
+	if err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return "", consts.ErrLinkCode
+	}
+	return student, nil
+}
+
+// fail 记录用户填错一次绑定码, 返回consts.ErrLinkCode
+func (m *CodeRedisMapper) fail(ctx context.Context, user string) error {
+	n, err := m.rds.IncrCtx(ctx, prefixFailKey+user)
+	if err != nil {
+		return err
+	}
+	if n == 1 {
+		if err = m.rds.ExpireCtx(ctx, prefixFailKey+user, m.failPeriod); err != nil {
+			return err
+		}
+	}
+	return consts.ErrLinkCode
+}
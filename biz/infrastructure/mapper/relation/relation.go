@@ -0,0 +1,14 @@
+package relation
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// Relation 是家长与学生账号的绑定关系, 家长可以只读地查看学生的学习数据
+type Relation struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	ParentId   string             `bson:"parent_id" json:"parentId"`
+	StudentId  string             `bson:"student_id" json:"studentId"`
+	CreateTime time.Time          `bson:"create_time" json:"createTime"`
+}
@@ -0,0 +1,126 @@
+package review
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	CollectionName = "review"
+	logId          = "log_id"
+	version        = "version"
+	teacherId      = "teacher_id"
+)
+
+type IMongoMapper interface {
+	Insert(ctx context.Context, r *Review) error
+	FindLatest(ctx context.Context, log string) (*Review, error)
+	FindOneByVersion(ctx context.Context, log string, v int64) (*Review, error)
+	FindManyByLog(ctx context.Context, log string) ([]*Review, error)
+	FindReviewed(ctx context.Context, logs []string) (map[string]bool, error)
+	DeleteByUserId(ctx context.Context, userId string) error
+	AnonymizeTeacher(ctx context.Context, teacher string) error
+}
+
+type MongoMapper struct {
+	conn *monc.Model
+}
+
+func NewMongoMapper(config *config.Config) *MongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, CollectionName, config.Cache)
+	// 同一批改的版本号唯一, 并发保存同一版本时只有一个成功
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: logId, Value: 1}, {Key: version, Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	_, err = conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: consts.UserID, Value: 1}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &MongoMapper{conn: conn}
+}
+
+// Insert 插入新版本, 版本号已存在时返回consts.ErrReviewConflict
+func (m *MongoMapper) Insert(ctx context.Context, r *Review) error {
+	if r.ID.IsZero() {
+		r.ID = primitive.NewObjectID()
+		r.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, r)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrReviewConflict
+	}
+	return err
+}
+
+// FindLatest 获取批改的最新批阅版本, 未批阅时返回consts.ErrNotFound
+func (m *MongoMapper) FindLatest(ctx context.Context, log string) (*Review, error) {
+	return m.findOne(ctx, bson.M{logId: log}, options.FindOne().SetSort(bson.M{version: -1}))
+}
+
+func (m *MongoMapper) FindOneByVersion(ctx context.Context, log string, v int64) (*Review, error) {
+	return m.findOne(ctx, bson.M{logId: log, version: v})
+}
+
+// FindManyByLog 获取批改的所有批阅版本, 按版本号正序
+func (m *MongoMapper) FindManyByLog(ctx context.Context, log string) ([]*Review, error) {
+	rs := make([]*Review, 0)
+	err := m.conn.Find(ctx, &rs, bson.M{logId: log}, options.Find().SetSort(bson.M{version: 1}))
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// FindReviewed 查询这些批改中已被批阅的批改
+func (m *MongoMapper) FindReviewed(ctx context.Context, logs []string) (map[string]bool, error) {
+	ids, err := m.conn.Distinct(ctx, logId, bson.M{logId: bson.M{"$in": logs}})
+	if err != nil {
+		return nil, err
+	}
+	reviewed := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if s, ok := id.(string); ok {
+			reviewed[s] = true
+		}
+	}
+	return reviewed, nil
+}
+
+// DeleteByUserId 删除学生所有批改的批阅
+func (m *MongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
+
+// AnonymizeTeacher 清除教师在批阅中的身份, 批阅内容作为学生作文的一部分保留
+func (m *MongoMapper) AnonymizeTeacher(ctx context.Context, teacher string) error {
+	_, err := m.conn.UpdateManyNoCache(ctx, bson.M{teacherId: teacher}, bson.M{"$set": bson.M{teacherId: ""}})
+	return err
+}
+
+func (m *MongoMapper) findOne(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (*Review, error) {
+	var r Review
+	err := m.conn.FindOneNoCache(ctx, &r, filter, opts...)
+	switch {
+	case err == nil:
+		return &r, nil
+	case errors.Is(err, monc.ErrNotFound):
+		return nil, consts.ErrNotFound
+	default:
+		return nil, err
+	}
+}
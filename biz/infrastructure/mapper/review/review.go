@@ -0,0 +1,132 @@
+package review
+
+import (
+	"fmt"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type (
+	// Review 是教师对一次批改的一个批阅版本, 叠加在AI批改结果之上, 不修改log.Log.Response
+	// 每次保存都会生成新版本, 版本号从1开始, 0表示AI批改结果本身
+	Review struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		LogId      string             `bson:"log_id" json:"logId"`
+		UserId     string             `bson:"user_id" json:"userId"` // 作文所属的学生
+		TeacherId  string             `bson:"teacher_id" json:"teacherId"`
+		Version    int64              `bson:"version" json:"version"`
+		Comments   []*Comment         `bson:"comments" json:"comments"`
+		Dimensions map[string]int64   `bson:"dimensions" json:"dimensions"` // 调整后的各维度得分, 未出现的维度沿用AI的得分
+		Score      *int64             `bson:"score,omitempty" json:"score,omitempty"`
+		Summary    string             `bson:"summary" json:"summary"`
+		Changes    []*Change          `bson:"changes" json:"changes"` // 相比上一版本的修改
+		CreateTime time.Time          `bson:"create_time" json:"createTime"`
+	}
+
+	// Comment 是教师对某一句的批注
+	Comment struct {
+		Paragraph int64  `bson:"paragraph" json:"paragraph"`
+		Sentence  int64  `bson:"sentence" json:"sentence"`
+		Text      string `bson:"text" json:"text"` // 被批注的原句
+		Content   string `bson:"content" json:"content"`
+	}
+
+	// Change 是两个版本之间一处字段的变化, 新增或删除时对应一侧为空
+	Change struct {
+		Field  string `bson:"field" json:"field"`
+		Before string `bson:"before" json:"before"`
+		After  string `bson:"after" json:"after"`
+	}
+)
+
+// 变化的字段名, 维度得分为dimensionPrefix加维度名, 批注为commentPrefix加位置
+const (
+	FieldScore      = "score"
+	FieldSummary    = "summary"
+	dimensionPrefix = "dimension."
+	commentPrefix   = "comment."
+)
+
+// FromLog 将AI批改结果表示为版本0, 用于和教师的版本对比
+func FromLog(l *log.Log) *Review {
+	r := &Review{
+		LogId:      l.ID.Hex(),
+		UserId:     l.UserId,
+		Dimensions: l.Dimensions(),
+		CreateTime: l.CreateTime,
+	}
+	if score, ok := l.Score(); ok {
+		r.Score = &score
+	}
+	return r
+}
+
+// Merge 返回叠加后的版本, 未调整的维度和总分沿用base
+func (r *Review) Merge(base *Review) *Review {
+	m := *r
+	m.Dimensions = make(map[string]int64, len(base.Dimensions)+len(r.Dimensions))
+	for k, v := range base.Dimensions {
+		m.Dimensions[k] = v
+	}
+	for k, v := range r.Dimensions {
+		m.Dimensions[k] = v
+	}
+	if m.Score == nil {
+		m.Score = base.Score
+	}
+	return &m
+}
+
+// Diff 比较两个版本, 变化按字段名排序
+func Diff(from, to *Review) []*Change {
+	cs := make([]*Change, 0)
+	add := func(field, before, after string) {
+		if before != after {
+			cs = append(cs, &Change{Field: field, Before: before, After: after})
+		}
+	}
+	add(FieldScore, formatScore(from.Score), formatScore(to.Score))
+	add(FieldSummary, from.Summary, to.Summary)
+	for k, v := range from.Dimensions {
+		after := ""
+		if w, ok := to.Dimensions[k]; ok {
+			after = strconv.FormatInt(w, 10)
+		}
+		add(dimensionPrefix+k, strconv.FormatInt(v, 10), after)
+	}
+	for k, v := range to.Dimensions {
+		if _, ok := from.Dimensions[k]; !ok {
+			add(dimensionPrefix+k, "", strconv.FormatInt(v, 10))
+		}
+	}
+	before, after := comments(from), comments(to)
+	for k, v := range before {
+		add(commentPrefix+k, v, after[k])
+	}
+	for k, v := range after {
+		if _, ok := before[k]; !ok {
+			add(commentPrefix+k, "", v)
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Field < cs[j].Field })
+	return cs
+}
+
+// comments 以"段.句"为键索引批注
+func comments(r *Review) map[string]string {
+	m := make(map[string]string, len(r.Comments))
+	for _, c := range r.Comments {
+		m[fmt.Sprintf("%d.%d", c.Paragraph, c.Sentence)] = c.Content
+	}
+	return m
+}
+
+func formatScore(score *int64) string {
+	if score == nil {
+		return ""
+	}
+	return strconv.FormatInt(*score, 10)
+}
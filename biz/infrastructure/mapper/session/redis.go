@@ -0,0 +1,42 @@
+package session
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/redis"
+	rds "github.com/zeromicro/go-zero/core/stores/redis"
+	"golang.org/x/net/context"
+	"strconv"
+	"time"
+)
+
+const prefixRevokeKey = "session:revoke:"
+
+type IRedisMapper interface {
+	Revoke(ctx context.Context, userId string, at time.Time) error
+	RevokeTime(ctx context.Context, userId string) (int64, error)
+}
+
+// RedisMapper 记录用户被强制下线的时间, 此前签发的token全部失效
+// 记录在token的最长有效期后过期, 届时此前签发的token已自然失效
+type RedisMapper struct {
+	rds    *rds.Redis
+	expire int
+}
+
+func NewRedisMapper(config *config.Config) *RedisMapper {
+	return &RedisMapper{rds: redis.GetRedis(config), expire: int(config.Auth.AccessExpire)}
+}
+
+// Revoke 使用户在at之前签发的token失效
+func (m *RedisMapper) Revoke(ctx context.Context, userId string, at time.Time) error {
+	return m.rds.SetexCtx(ctx, prefixRevokeKey+userId, strconv.FormatInt(at.Unix(), 10), m.expire)
+}
+
+// RevokeTime 返回用户被强制下线的时间戳, 没有记录时返回0
+func (m *RedisMapper) RevokeTime(ctx context.Context, userId string) (int64, error) {
+	v, err := m.rds.GetCtx(ctx, prefixRevokeKey+userId)
+	if err != nil || v == "" {
+		return 0, err
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
@@ -3,25 +3,44 @@ package user
 import (
 	"context"
 	"errors"
+	"github.com/xh-polaris/essay-show/biz/application/dto/basic"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	util "github.com/xh-polaris/essay-show/biz/infrastructure/util/page"
 	"github.com/zeromicro/go-zero/core/stores/monc"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
 	"time"
 )
 
 const (
 	prefixUserCacheKey = "cache:user"
 	CollectionName     = "user"
+	deleteTime         = "delete_time"
+	quotaRefs          = "quota_refs" // 已生效的一次性次数变动, 不在User结构中, 避免Update整体写回时覆盖
 )
 
 type IMongoMapper interface {
 	Insert(ctx context.Context, user *User) error
-	Update(ctx context.Context, user *User) error
+	UpdateInfo(ctx context.Context, user *User) error
 	FindOne(ctx context.Context, id string) (*User, error)
 	FindOneByPhone(ctx context.Context, id string) (*User, error)
-	UpdateCount(ctx context.Context, id string, increment int64) error
+	FindManyByIds(ctx context.Context, ids []string) ([]*User, error)
+	UpdateCount(ctx context.Context, id string, increment int64) (int64, error)
+	UpdateCountOnce(ctx context.Context, id string, increment int64, ref string) (int64, error)
+	SetCount(ctx context.Context, id string, count int64) error
+	UpdateMakeUpCard(ctx context.Context, id string, increment int64) error
+	UpdateRole(ctx context.Context, id string, role string) error
+	UpdateRankHidden(ctx context.Context, id string, hidden bool) error
+	UpdateStatus(ctx context.Context, id string, status int) error
+	Search(ctx context.Context, keyword string, p *basic.PaginationOptions) (us []*User, total int64, err error)
+	ScheduleDelete(ctx context.Context, id string, t time.Time) error
+	CancelDelete(ctx context.Context, id string) error
+	FindDueDeletion(ctx context.Context, now time.Time) ([]*User, error)
+	Anonymize(ctx context.Context, id string, now time.Time) error
 }
 
 type MongoMapper struct {
@@ -45,9 +64,16 @@ func (m *MongoMapper) Insert(ctx context.Context, user *User) error {
 	return err
 }
 
-func (m *MongoMapper) Update(ctx context.Context, user *User) error {
+// UpdateInfo 只更新用户可编辑的资料字段, 避免整体写回时覆盖并发修改的次数、状态等字段
+func (m *MongoMapper) UpdateInfo(ctx context.Context, user *User) error {
 	user.UpdateTime = time.Now()
-	_, err := m.conn.UpdateByIDNoCache(ctx, user.ID, bson.M{"$set": user})
+	_, err := m.conn.UpdateByIDNoCache(ctx, user.ID, bson.M{"$set": bson.M{
+		"username":    user.Username,
+		"avatar":      user.Avatar,
+		"school":      user.School,
+		"grade":       user.Grade,
+		"update_time": user.UpdateTime,
+	}})
 	return err
 }
 
@@ -81,14 +107,282 @@ func (m *MongoMapper) FindOneByPhone(ctx context.Context, phone string) (*User,
 	}
 }
 
-func (m *MongoMapper) UpdateCount(ctx context.Context, id string, increment int64) error {
+func (m *MongoMapper) FindManyByIds(ctx context.Context, ids []string) ([]*User, error) {
+	oids := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if oid, err := primitive.ObjectIDFromHex(id); err == nil {
+			oids = append(oids, oid)
+		}
+	}
+	us := make([]*User, 0, len(oids))
+	err := m.conn.Find(ctx, &us, bson.M{
+		consts.ID: bson.M{"$in": oids},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
+// UpdateCount 增减剩余批改次数, 返回变动后的次数, 减少时次数不足返回consts.ErrInSufficientCount
+func (m *MongoMapper) UpdateCount(ctx context.Context, id string, increment int64) (int64, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return 0, consts.ErrInvalidObjectId
+	}
+	u := &User{}
+	err = m.conn.FindOneAndUpdateNoCache(ctx, u, countFilter(bson.M{consts.ID: oid}, increment), bson.M{
+		"$inc": bson.M{
+			"count": increment,
+		},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return u.Count, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		if _, err = m.FindOne(ctx, id); err != nil {
+			return 0, err
+		}
+		return 0, consts.ErrInSufficientCount
+	default:
+		return 0, err
+	}
+}
+
+// countFilter 减少次数时要求剩余次数足够, 使检查与扣减原子完成
+func countFilter(filter bson.M, increment int64) bson.M {
+	if increment < 0 {
+		filter["count"] = bson.M{"$gte": -increment}
+	}
+	return filter
+}
+
+// UpdateCountOnce 与UpdateCount相同, 但同一ref只生效一次, 已生效过时不再修改并返回当前次数
+func (m *MongoMapper) UpdateCountOnce(ctx context.Context, id string, increment int64, ref string) (int64, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return 0, consts.ErrInvalidObjectId
+	}
+	u := &User{}
+	err = m.conn.FindOneAndUpdateNoCache(ctx, u, countFilter(bson.M{consts.ID: oid, quotaRefs: bson.M{consts.NotEqual: ref}}, increment), bson.M{
+		"$inc":  bson.M{"count": increment},
+		"$push": bson.M{quotaRefs: ref},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return u.Count, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		n, err := m.conn.CountDocuments(ctx, bson.M{consts.ID: oid, quotaRefs: ref})
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			if _, err = m.FindOne(ctx, id); err != nil {
+				return 0, err
+			}
+			return 0, consts.ErrInSufficientCount
+		}
+		if u, err = m.FindOne(ctx, id); err != nil {
+			return 0, err
+		}
+		return u.Count, nil
+	default:
+		return 0, err
+	}
+}
+
+// SetCount 直接设置剩余批改次数, 仅用于按账本重建
+func (m *MongoMapper) SetCount(ctx context.Context, id string, count int64) error {
 	oid, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
 		return consts.ErrInvalidObjectId
 	}
 	_, err = m.conn.UpdateByIDNoCache(ctx, oid, bson.M{
+		"$set": bson.M{
+			"count": count,
+		},
+	})
+	return err
+}
+
+// UpdateMakeUpCard 增减补签卡数量, 减少时数量不足返回consts.ErrNoMakeUpCard
+func (m *MongoMapper) UpdateMakeUpCard(ctx context.Context, id string, increment int64) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	filter := bson.M{consts.ID: oid}
+	if increment < 0 {
+		filter["make_up_card"] = bson.M{"$gte": -increment}
+	}
+	res, err := m.conn.UpdateOneNoCache(ctx, filter, bson.M{
 		"$inc": bson.M{
-			"count": increment,
+			"make_up_card": increment,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return consts.ErrNoMakeUpCard
+	}
+	return nil
+}
+
+// UpdateRole 设置用户的角色
+func (m *MongoMapper) UpdateRole(ctx context.Context, id string, role string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	res, err := m.conn.UpdateByIDNoCache(ctx, oid, bson.M{
+		"$set": bson.M{
+			"role":        role,
+			"update_time": time.Now(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateRankHidden 设置是否在排行榜中隐藏, 只修改该字段
+func (m *MongoMapper) UpdateRankHidden(ctx context.Context, id string, hidden bool) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	res, err := m.conn.UpdateByIDNoCache(ctx, oid, bson.M{
+		"$set": bson.M{
+			"rank_hidden": hidden,
+			"update_time": time.Now(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateStatus 设置用户的状态, 用于封禁和解封
+func (m *MongoMapper) UpdateStatus(ctx context.Context, id string, status int) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	res, err := m.conn.UpdateByIDNoCache(ctx, oid, bson.M{
+		"$set": bson.M{
+			consts.Status: status,
+			"update_time": time.Now(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return consts.ErrNotFound
+	}
+	return nil
+}
+
+// Search 按手机号前缀、用户名或学校分页搜索用户, 按注册时间倒序
+func (m *MongoMapper) Search(ctx context.Context, keyword string, p *basic.PaginationOptions) (us []*User, total int64, err error) {
+	skip, limit := util.ParsePageOpt(p)
+	quoted := regexp.QuoteMeta(keyword)
+	filter := bson.M{"$or": bson.A{
+		bson.M{consts.Phone: primitive.Regex{Pattern: "^" + quoted}},
+		bson.M{"username": primitive.Regex{Pattern: quoted}},
+		bson.M{"school": primitive.Regex{Pattern: quoted}},
+	}}
+	us = make([]*User, 0, limit)
+	err = m.conn.Find(ctx, &us, filter, &options.FindOptions{
+		Skip:  &skip,
+		Limit: &limit,
+		Sort:  bson.D{{Key: consts.CreateTime, Value: -1}},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err = m.conn.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return us, total, nil
+}
+
+// ScheduleDelete 申请注销账号, 在t时刻之后清除用户数据
+func (m *MongoMapper) ScheduleDelete(ctx context.Context, id string, t time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	_, err = m.conn.UpdateOneNoCache(ctx, bson.M{
+		consts.ID:     oid,
+		consts.Status: bson.M{consts.NotEqual: consts.DeleteStatus},
+	}, bson.M{
+		"$set": bson.M{
+			deleteTime:    t,
+			"update_time": time.Now(),
+		},
+	})
+	return err
+}
+
+// CancelDelete 在冷静期内撤销注销申请
+func (m *MongoMapper) CancelDelete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	_, err = m.conn.UpdateOneNoCache(ctx, bson.M{
+		consts.ID:     oid,
+		consts.Status: bson.M{consts.NotEqual: consts.DeleteStatus},
+	}, bson.M{
+		"$unset": bson.M{deleteTime: ""},
+		"$set":   bson.M{"update_time": time.Now()},
+	})
+	return err
+}
+
+// FindDueDeletion 查找冷静期已过但还未清除数据的用户
+func (m *MongoMapper) FindDueDeletion(ctx context.Context, now time.Time) ([]*User, error) {
+	us := make([]*User, 0)
+	err := m.conn.Find(ctx, &us, bson.M{
+		deleteTime:    bson.M{"$lte": now},
+		consts.Status: bson.M{consts.NotEqual: consts.DeleteStatus},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
+// Anonymize 清除用户的个人信息并标记为已注销, 保留文档以免其他集合中的用户id失去指向
+// 剩余次数须先经账本清零, 这里不直接修改
+func (m *MongoMapper) Anonymize(ctx context.Context, id string, now time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return consts.ErrInvalidObjectId
+	}
+	_, err = m.conn.UpdateByIDNoCache(ctx, oid, bson.M{
+		"$set": bson.M{
+			"username":     "已注销用户",
+			"avatar":       "",
+			consts.Phone:   "",
+			"school":       "",
+			"grade":        0,
+			"make_up_card": 0,
+			"rank_hidden":  true,
+			consts.Status:  consts.DeleteStatus,
+			deleteTime:     now,
+			"update_time":  now,
 		},
 	})
 	return err
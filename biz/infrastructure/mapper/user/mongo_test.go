@@ -0,0 +1,89 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/testutil"
+	"sync"
+	"testing"
+)
+
+// 并发扣除次数时剩余次数不会变为负数
+func TestUpdateCountConcurrently(t *testing.T) {
+	m := NewMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	u := &User{Username: "count-test", Count: 3}
+	if err := m.Insert(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	id := u.ID.Hex()
+
+	const concurrency = 10
+	errs := make([]error, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = m.UpdateCount(ctx, id, -1)
+		}(i)
+	}
+	wg.Wait()
+
+	ok := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			ok++
+		case !errors.Is(err, consts.ErrInSufficientCount):
+			t.Fatalf("UpdateCount #%d error %v, want ErrInSufficientCount", i, err)
+		}
+	}
+	if ok != 3 {
+		t.Fatalf("%d decrements succeeded, want 3", ok)
+	}
+
+	// 一次性扣除同样受剩余次数限制, 已生效的ref重复调用返回当前次数
+	if _, err := m.UpdateCountOnce(ctx, id, -1, "ref"); !errors.Is(err, consts.ErrInSufficientCount) {
+		t.Fatalf("UpdateCountOnce error %v, want ErrInSufficientCount", err)
+	}
+	for i := 0; i < 2; i++ {
+		if n, err := m.UpdateCountOnce(ctx, id, 2, "grant"); err != nil || n != 2 {
+			t.Fatalf("UpdateCountOnce #%d = %d, %v, want 2", i, n, err)
+		}
+	}
+	if _, err := m.UpdateCount(ctx, "000000000000000000000000", -1); !errors.Is(err, consts.ErrNotFound) {
+		t.Fatalf("UpdateCount missing user error %v, want ErrNotFound", err)
+	}
+}
+
+// 更新资料不会覆盖并发修改的剩余次数
+func TestUpdateInfo(t *testing.T) {
+	m := NewMongoMapper(testutil.LoadDBConfig(t))
+	ctx := context.Background()
+
+	u := &User{Username: "info-test", Count: 3}
+	if err := m.Insert(ctx, u); err != nil {
+		t.Fatal(err)
+	}
+	stale, err := m.FindOne(ctx, u.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.UpdateCount(ctx, u.ID.Hex(), 5); err != nil {
+		t.Fatal(err)
+	}
+	stale.Username = "renamed"
+	if err = m.UpdateInfo(ctx, stale); err != nil {
+		t.Fatal(err)
+	}
+	got, err := m.FindOne(ctx, u.ID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Username != "renamed" || got.Count != 8 {
+		t.Fatalf("user = %s/%d, want renamed/8", got.Username, got.Count)
+	}
+}
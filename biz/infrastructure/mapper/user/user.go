@@ -13,8 +13,22 @@ type User struct {
 	Count      int64              `bson:"count" json:"count"` // 剩余可用批改次数
 	Status     int                `bson:"status" json:"status"`
 	School     string             `bson:"school" json:"school"`
-	Grade      int64              `bson:"grade" json:"grade"` // 默认0，从一开始依次递增
+	Grade      int64              `bson:"grade" json:"grade"`             // 默认0，从一开始依次递增
+	RankHidden bool               `bson:"rank_hidden" json:"rankHidden"`  // 是否在排行榜中隐藏
+	MakeUpCard int64              `bson:"make_up_card" json:"makeUpCard"` // 剩余补签卡数量
+	Role       string             `bson:"role,omitempty" json:"role"`     // 角色, 为空时视为学生
 	CreateTime time.Time          `bson:"create_time,omitempty" json:"createTime"`
 	UpdateTime time.Time          `bson:"update_time,omitempty" json:"updateTime"`
 	DeleteTime time.Time          `bson:"delete_time,omitempty" json:"deleteTime"`
 }
+
+// 用户角色
+const (
+	RoleStudent = "student"
+	RoleTeacher = "teacher"
+	RoleParent  = "parent"
+	RoleAdmin   = "admin"
+)
+
+// Roles 所有可以分配的角色
+var Roles = []string{RoleStudent, RoleTeacher, RoleParent, RoleAdmin}
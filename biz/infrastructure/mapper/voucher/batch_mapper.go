@@ -0,0 +1,35 @@
+package voucher
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	batchCollectionName = "voucher_batch"
+)
+
+type IBatchMongoMapper interface {
+	Insert(ctx context.Context, b *Batch) error
+}
+
+type BatchMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewBatchMongoMapper(config *config.Config) *BatchMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, batchCollectionName, config.Cache)
+	return &BatchMongoMapper{conn: conn}
+}
+
+func (m *BatchMongoMapper) Insert(ctx context.Context, b *Batch) error {
+	if b.ID.IsZero() {
+		b.ID = primitive.NewObjectID()
+		b.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, b)
+	return err
+}
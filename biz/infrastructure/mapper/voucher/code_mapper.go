@@ -0,0 +1,92 @@
+package voucher
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	codeCollectionName = "voucher_code"
+	alphabet           = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789" // 去掉了容易混淆的I、O、0、1
+	codeLength         = 12
+	maxRetry           = 10
+	code               = "code"
+	used               = "used"
+	maxUses            = "max_uses"
+	expireTime         = "expire_time"
+)
+
+type ICodeMongoMapper interface {
+	Insert(ctx context.Context, c *Code) error
+	Take(ctx context.Context, s string, now time.Time) (*Code, error)
+	Release(ctx context.Context, c *Code) error
+}
+
+type CodeMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewCodeMongoMapper(config *config.Config) *CodeMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, codeCollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: code, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &CodeMongoMapper{conn: conn}
+}
+
+// Insert 生成随机兑换码并插入, 与已有兑换码冲突时重新生成
+func (m *CodeMongoMapper) Insert(ctx context.Context, c *Code) error {
+	c.ID = primitive.NewObjectID()
+	c.CreateTime = time.Now()
+	for i := 0; i < maxRetry; i++ {
+		s, err := util.RandomCode(alphabet, codeLength)
+		if err != nil {
+			return err
+		}
+		c.Code = s
+		_, err = m.conn.InsertOneNoCache(ctx, c)
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+	return consts.ErrVoucherCode
+}
+
+// Take 占用兑换码的一次兑换机会, 兑换码不存在、已过期或已用完时返回consts.ErrVoucher
+func (m *CodeMongoMapper) Take(ctx context.Context, s string, now time.Time) (*Code, error) {
+	c := &Code{}
+	err := m.conn.FindOneAndUpdateNoCache(ctx, c, bson.M{
+		code:       s,
+		expireTime: bson.M{"$gt": now},
+		"$expr":    bson.M{"$lt": bson.A{"$" + used, "$" + maxUses}},
+	}, bson.M{
+		"$inc": bson.M{used: 1},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	switch {
+	case err == nil:
+		return c, nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return nil, consts.ErrVoucher
+	default:
+		return nil, err
+	}
+}
+
+// Release 兑换失败时归还占用的兑换机会
+func (m *CodeMongoMapper) Release(ctx context.Context, c *Code) error {
+	_, err := m.conn.UpdateByIDNoCache(ctx, c.ID, bson.M{"$inc": bson.M{used: -1}})
+	return err
+}
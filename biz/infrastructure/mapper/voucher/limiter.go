@@ -0,0 +1,46 @@
+package voucher
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/redis"
+	"github.com/zeromicro/go-zero/core/limit"
+	"golang.org/x/net/context"
+)
+
+// RedeemLimiter 按用户和IP限制兑换的尝试次数, 防止暴力猜测兑换码
+type RedeemLimiter struct {
+	user *limit.PeriodLimit
+	ip   *limit.PeriodLimit
+}
+
+func NewRedeemLimiter(config *config.Config) *RedeemLimiter {
+	c := config.Voucher
+	rds := redis.GetRedis(config)
+	return &RedeemLimiter{
+		user: limit.NewPeriodLimit(c.LimitPeriod, c.UserLimit, rds, "limit:voucher:user:"),
+		ip:   limit.NewPeriodLimit(c.LimitPeriod, c.IPLimit, rds, "limit:voucher:ip:"),
+	}
+}
+
+// Allow 记录一次尝试, 超出限制时返回consts.ErrTooManyAttempts
+func (l *RedeemLimiter) Allow(ctx context.Context, userId, ip string) error {
+	code, err := l.user.TakeCtx(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if code == limit.OverQuota {
+		return consts.ErrTooManyAttempts
+	}
+	if ip == "" {
+		return nil
+	}
+	code, err = l.ip.TakeCtx(ctx, ip)
+	if err != nil {
+		return err
+	}
+	if code == limit.OverQuota {
+		return consts.ErrTooManyAttempts
+	}
+	return nil
+}
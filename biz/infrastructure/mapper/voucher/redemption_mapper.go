@@ -0,0 +1,76 @@
+package voucher
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
+	"github.com/zeromicro/go-zero/core/stores/monc"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/net/context"
+	"time"
+)
+
+const (
+	redemptionCollectionName = "voucher_redemption"
+	codeId                   = "code_id"
+	pending                  = "pending"
+)
+
+type IRedemptionMongoMapper interface {
+	Insert(ctx context.Context, r *Redemption) error
+	FindPending(ctx context.Context, userId string) ([]*Redemption, error)
+	MarkGranted(ctx context.Context, id primitive.ObjectID) error
+	DeleteByUserId(ctx context.Context, userId string) error
+}
+
+type RedemptionMongoMapper struct {
+	conn *monc.Model
+}
+
+func NewRedemptionMongoMapper(config *config.Config) *RedemptionMongoMapper {
+	conn := monc.MustNewModel(config.Mongo.URL, config.Mongo.DB, redemptionCollectionName, config.Cache)
+	_, err := conn.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: codeId, Value: 1}, {Key: consts.UserID, Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(err)
+	}
+	return &RedemptionMongoMapper{conn: conn}
+}
+
+// Insert 插入兑换记录, 用户已兑换过该兑换码时返回consts.ErrRepeatRedeem
+func (m *RedemptionMongoMapper) Insert(ctx context.Context, r *Redemption) error {
+	if r.ID.IsZero() {
+		r.ID = primitive.NewObjectID()
+		r.CreateTime = time.Now()
+	}
+	_, err := m.conn.InsertOneNoCache(ctx, r)
+	if mongo.IsDuplicateKeyError(err) {
+		return consts.ErrRepeatRedeem
+	}
+	return err
+}
+
+// FindPending 获取用户已记录但次数尚未发放的兑换记录
+func (m *RedemptionMongoMapper) FindPending(ctx context.Context, userId string) ([]*Redemption, error) {
+	rs := make([]*Redemption, 0)
+	if err := m.conn.Find(ctx, &rs, bson.M{consts.UserID: userId, pending: true}); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// MarkGranted 标记兑换记录的次数已发放
+func (m *RedemptionMongoMapper) MarkGranted(ctx context.Context, id primitive.ObjectID) error {
+	_, err := m.conn.UpdateByIDNoCache(ctx, id, bson.M{"$unset": bson.M{pending: ""}})
+	return err
+}
+
+// DeleteByUserId 删除用户所有的兑换记录, 兑换发放的次数已记入账本
+func (m *RedemptionMongoMapper) DeleteByUserId(ctx context.Context, userId string) error {
+	_, err := m.conn.DeleteMany(ctx, bson.M{consts.UserID: userId})
+	return err
+}
@@ -0,0 +1,44 @@
+package voucher
+
+import (
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+type (
+	// Batch 是管理员一次创建的一批兑换码
+	Batch struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		Name       string             `bson:"name" json:"name"`
+		Credits    int64              `bson:"credits" json:"credits"`                   // 每次兑换获得的批改次数
+		Count      int64              `bson:"count" json:"count"`                       // 兑换码数量
+		MaxUses    int64              `bson:"max_uses" json:"maxUses"`                  // 每个兑换码可被兑换的次数
+		School     string             `bson:"school,omitempty" json:"school,omitempty"` // 仅限该学校的用户兑换
+		ExpireTime time.Time          `bson:"expire_time" json:"expireTime"`
+		CreatorId  string             `bson:"creator_id" json:"creatorId"`
+		CreateTime time.Time          `bson:"create_time" json:"createTime"`
+	}
+
+	// Code 是一个兑换码, 冗余了批次的兑换规则, 以便兑换时一次原子操作完成校验和占用
+	Code struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		BatchId    string             `bson:"batch_id" json:"batchId"`
+		Code       string             `bson:"code" json:"code"`
+		Credits    int64              `bson:"credits" json:"credits"`
+		MaxUses    int64              `bson:"max_uses" json:"maxUses"`
+		Used       int64              `bson:"used" json:"used"` // 已被兑换的次数
+		School     string             `bson:"school,omitempty" json:"school,omitempty"`
+		ExpireTime time.Time          `bson:"expire_time" json:"expireTime"`
+		CreateTime time.Time          `bson:"create_time" json:"createTime"`
+	}
+
+	// Redemption 是一次兑换记录, 同一用户对同一兑换码只能兑换一次
+	Redemption struct {
+		ID         primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+		CodeId     string             `bson:"code_id" json:"codeId"`
+		UserId     string             `bson:"user_id" json:"userId"`
+		Credits    int64              `bson:"credits" json:"credits"`
+		Pending    bool               `bson:"pending,omitempty" json:"pending,omitempty"` // 已记录但次数尚未发放
+		CreateTime time.Time          `bson:"create_time" json:"createTime"`
+	}
+)
@@ -0,0 +1,72 @@
+package moderation
+
+// matcher 是基于Aho-Corasick自动机的多模式匹配, 一次扫描找出文本中出现的所有词
+type matcher struct {
+	nodes []*node
+}
+
+type node struct {
+	next map[rune]int
+	fail int
+	out  []int // 以该节点结尾的词的下标, 包含经由失配指针可达的词
+}
+
+func newMatcher(words []string) *matcher {
+	m := &matcher{nodes: []*node{{next: map[rune]int{}}}}
+	for i, w := range words {
+		cur := 0
+		for _, r := range w {
+			nxt, ok := m.nodes[cur].next[r]
+			if !ok {
+				nxt = len(m.nodes)
+				m.nodes = append(m.nodes, &node{next: map[rune]int{}})
+				m.nodes[cur].next[r] = nxt
+			}
+			cur = nxt
+		}
+		if cur != 0 {
+			m.nodes[cur].out = append(m.nodes[cur].out, i)
+		}
+	}
+	// 按层构建失配指针, 父节点的失配指针总是先于子节点确定
+	queue := make([]int, 0, len(m.nodes))
+	for _, c := range m.nodes[0].next {
+		queue = append(queue, c)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, c := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f != 0 && m.nodes[f].next[r] == 0 {
+				f = m.nodes[f].fail
+			}
+			if t, ok := m.nodes[f].next[r]; ok && t != c {
+				m.nodes[c].fail = t
+			}
+			m.nodes[c].out = append(m.nodes[c].out, m.nodes[m.nodes[c].fail].out...)
+			queue = append(queue, c)
+		}
+	}
+	return m
+}
+
+// match 返回文本中出现的词的下标, 每个词只返回一次
+func (m *matcher) match(text string) []int {
+	found := make([]int, 0)
+	seen := make(map[int]bool)
+	cur := 0
+	for _, r := range text {
+		for cur != 0 && m.nodes[cur].next[r] == 0 {
+			cur = m.nodes[cur].fail
+		}
+		cur = m.nodes[cur].next[r]
+		for _, i := range m.nodes[cur].out {
+			if !seen[i] {
+				seen[i] = true
+				found = append(found, i)
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,102 @@
+package moderation
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 判断非作文输入的阈值
+const (
+	minDiversityLen = 50  // 文本达到该长度才检查字符多样性
+	minDiversity    = 0.1 // 不同字符数占总字符数的最低比例
+	maxRepeatRun    = 10  // 同一字母或数字最多连续出现的次数
+	maxHanRun       = 30  // 同一汉字最多连续出现的次数, 作文中常有"哈哈哈哈"、"啊啊啊啊"这样的叠字, 因此放宽
+	maxLatinRun     = 20  // 不含元音的连续字母最长长度, 用于识别乱敲键盘
+	minCodeLines    = 3   // 至少有几行像代码才视为代码
+	minCodeRatio    = 0.3 // 像代码的行占非空行的最低比例
+)
+
+// codePrefixes 是常见编程语言的行首关键字
+var codePrefixes = []string{
+	"func ", "def ", "class ", "import ", "package ", "#include", "public ", "private ", "return ",
+	"var ", "let ", "const ", "for (", "for(", "if (", "while (", "print(", "console.", "using ", "//",
+}
+
+// gibberish 判断文本是否为乱码或无意义的重复
+func gibberish(text string) bool {
+	rs := make([]rune, 0, len(text))
+	for _, r := range text {
+		if !unicode.IsSpace(r) {
+			rs = append(rs, r)
+		}
+	}
+	// 字符多样性只统计字母和数字, 避免成串的标点拉低比例
+	total, distinct := 0, make(map[rune]bool)
+	for _, r := range rs {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			total++
+			distinct[r] = true
+		}
+	}
+	if total >= minDiversityLen && float64(len(distinct)) < minDiversity*float64(total) {
+		return true
+	}
+	// 只统计字母和数字的连续重复, "……"、"！！！"等标点不计
+	run := 0
+	for i, r := range rs {
+		if i > 0 && r == rs[i-1] && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			run++
+		} else {
+			run = 1
+		}
+		limit := maxRepeatRun
+		if unicode.Is(unicode.Han, r) {
+			limit = maxHanRun
+		}
+		if run > limit {
+			return true
+		}
+	}
+	// 乱敲键盘产生的长串字母通常不含元音
+	latin := 0
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= 'a' && r <= 'z' && !strings.ContainsRune("aeiouy", r):
+			latin++
+		default:
+			latin = 0
+		}
+		if latin > maxLatinRun {
+			return true
+		}
+	}
+	return false
+}
+
+// code 判断文本是否主要是粘贴的代码
+func code(text string) bool {
+	lines, codeLines := 0, 0
+	for _, l := range strings.Split(text, "\n") {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+		lines++
+		if codeLine(l) {
+			codeLines++
+		}
+	}
+	return codeLines >= minCodeLines && float64(codeLines) >= minCodeRatio*float64(lines)
+}
+
+func codeLine(l string) bool {
+	if strings.HasSuffix(l, ";") || strings.HasSuffix(l, "{") || l == "}" || strings.HasSuffix(l, "):") {
+		return true
+	}
+	for _, p := range codePrefixes {
+		if strings.HasPrefix(l, p) {
+			return true
+		}
+	}
+	return strings.Contains(l, "==") || strings.Contains(l, "=>") || strings.Contains(l, "</")
+}
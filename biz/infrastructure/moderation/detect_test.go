@@ -0,0 +1,74 @@
+package moderation
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"strings"
+	"testing"
+)
+
+// essay 是一篇正常的小学生作文, 包含叠字、省略号和连续的感叹号
+const essay = `快乐的周末
+
+星期六的早上，阳光明媚，爸爸妈妈带我去公园放风筝。一到公园，我就迫不及待地拿出了我的蝴蝶风筝。
+我拉着线跑啊跑啊，风筝却怎么也飞不起来……我急得满头大汗。爸爸笑着说："别着急，要逆着风跑。"
+我照着爸爸说的做，风筝终于慢慢地飞上了天空！！！我高兴得大叫："啊啊啊啊啊啊啊啊啊啊啊啊，飞起来啦！"
+妹妹在旁边拍着手笑："哈哈哈哈哈哈，哥哥的风筝飞得好高好高！"我们一直玩到太阳下山才依依不舍地回家。
+这真是一个快快乐乐、开开心心的周末，我希望下个周末还能再来。`
+
+func TestGibberish(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{"chinese essay", essay, false},
+		{"chinese essay without spaces", strings.Join(strings.Fields(essay), ""), false},
+		{"han reduplication", "今天我太开心了" + strings.Repeat("哈", 20) + "，我考了一百分。", false},
+		{"punctuation run", "他走了" + strings.Repeat("……", 20) + strings.Repeat("！", 20), false},
+		{"english essay", "My favourite season is autumn because the leaves turn golden and the air is cool.", false},
+		{"han spam", strings.Repeat("哈", 40), true},
+		{"letter spam", "aaaaaaaaaaaaaaaaaaaaaaa", true},
+		{"digit spam", "作文" + strings.Repeat("6", 15), true},
+		{"keyboard mash", "sdfghjklqwrtpzxcvbnmlkjhgfdsqwrtp", true},
+		{"low diversity", strings.Repeat("我是我是我是", 20), true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := gibberish(c.text); got != c.want {
+				t.Errorf("gibberish(%q) = %v, want %v", c.text, got, c.want)
+			}
+		})
+	}
+}
+
+func TestCode(t *testing.T) {
+	cases := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{"chinese essay", essay, false},
+		{"go", "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"hello\")\n}", true},
+		{"python", "def add(a, b):\n    return a + b\n\nprint(add(1, 2))", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := code(c.text); got != c.want {
+				t.Errorf("code(%q) = %v, want %v", c.text, got, c.want)
+			}
+		})
+	}
+}
+
+func TestCheckChineseEssay(t *testing.T) {
+	m, err := NewModerator(&config.Config{Moderation: config.Moderation{Enabled: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := m.Check(essay); res.Verdict != VerdictPass {
+		t.Errorf("Check(essay) = %+v, want pass", res)
+	}
+	if res := m.Check(strings.Repeat("哈", 40)); !res.Blocked() {
+		t.Errorf("Check(spam) = %+v, want block", res)
+	}
+}
@@ -0,0 +1,141 @@
+package moderation
+
+import (
+	"bufio"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"os"
+	"strings"
+	"unicode"
+)
+
+// 审核结论
+const (
+	VerdictPass  = "pass"  // 通过
+	VerdictBlock = "block" // 拒绝批改
+	VerdictAlert = "alert" // 正常批改, 但需要人工关注
+)
+
+// 审核原因, 前三种拒绝批改, 后两种提醒人工关注
+const (
+	ReasonKeyword   = "keyword"   // 命中敏感词
+	ReasonGibberish = "gibberish" // 乱码或无意义的重复
+	ReasonCode      = "code"      // 粘贴的代码
+	ReasonSelfHarm  = "self_harm" // 自伤倾向
+	ReasonBullying  = "bullying"  // 遭受霸凌
+)
+
+// Result 是一次审核的结果
+type Result struct {
+	Verdict string
+	Reasons []string
+	Hits    []string // 命中的词
+}
+
+// Blocked 判断是否拒绝批改
+func (r *Result) Blocked() bool {
+	return r.Verdict == VerdictBlock
+}
+
+// Alerted 判断是否需要人工关注, 被拒绝的提交也可能需要关注
+func (r *Result) Alerted() bool {
+	for _, reason := range r.Reasons {
+		if reason == ReasonSelfHarm || reason == ReasonBullying {
+			return true
+		}
+	}
+	return false
+}
+
+// Moderator 审核提交的作文, 词典在创建时构建为自动机, 之后只读, 可以并发使用
+type Moderator struct {
+	enabled bool
+	matcher *matcher
+	words   []string
+	reasons []string // 与words一一对应
+}
+
+func NewModerator(config *config.Config) (*Moderator, error) {
+	c := config.Moderation
+	m := &Moderator{enabled: c.Enabled}
+	keywords := append([]string{}, c.Keywords...)
+	if c.KeywordFile != "" {
+		ws, err := readWords(c.KeywordFile)
+		if err != nil {
+			return nil, err
+		}
+		keywords = append(keywords, ws...)
+	}
+	m.add(ReasonKeyword, keywords)
+	m.add(ReasonSelfHarm, append(builtinSelfHarm, c.SelfHarm...))
+	m.add(ReasonBullying, append(builtinBullying, c.Bullying...))
+	m.matcher = newMatcher(m.words)
+	return m, nil
+}
+
+// Check 审核作文, 未启用时总是通过
+func (m *Moderator) Check(text string) *Result {
+	res := &Result{Verdict: VerdictPass, Reasons: make([]string, 0), Hits: make([]string, 0)}
+	if !m.enabled {
+		return res
+	}
+	reasons := make(map[string]bool)
+	for _, i := range m.matcher.match(normalize(text)) {
+		reasons[m.reasons[i]] = true
+		res.Hits = append(res.Hits, m.words[i])
+	}
+	if gibberish(text) {
+		reasons[ReasonGibberish] = true
+	}
+	if code(text) {
+		reasons[ReasonCode] = true
+	}
+	for _, r := range []string{ReasonKeyword, ReasonGibberish, ReasonCode, ReasonSelfHarm, ReasonBullying} {
+		if reasons[r] {
+			res.Reasons = append(res.Reasons, r)
+		}
+	}
+	switch {
+	case reasons[ReasonKeyword] || reasons[ReasonGibberish] || reasons[ReasonCode]:
+		res.Verdict = VerdictBlock
+	case len(res.Reasons) > 0:
+		res.Verdict = VerdictAlert
+	}
+	return res
+}
+
+func (m *Moderator) add(reason string, words []string) {
+	for _, w := range words {
+		if w = normalize(w); w != "" {
+			m.words = append(m.words, w)
+			m.reasons = append(m.reasons, reason)
+		}
+	}
+}
+
+// normalize 转为小写并去掉空白和标点, 避免用分隔符绕过词典
+func normalize(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// readWords 读取词典文件, 每行一个词, 忽略空行和#开头的注释
+func readWords(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	ws := make([]string, 0)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if w := strings.TrimSpace(sc.Text()); w != "" && !strings.HasPrefix(w, "#") {
+			ws = append(ws, w)
+		}
+	}
+	return ws, sc.Err()
+}
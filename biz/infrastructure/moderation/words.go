@@ -0,0 +1,13 @@
+package moderation
+
+// builtinSelfHarm 是内置的自伤倾向短语, 命中后提醒人工关注, 可以通过配置补充
+var builtinSelfHarm = []string{
+	"想自杀", "我要自杀", "不想活了", "活着没意思", "活着没有意义", "想去死", "我想死", "想结束自己的生命",
+	"结束自己的生命", "割腕", "自残", "伤害自己", "轻生", "跳楼", "一了百了", "离开这个世界", "没有人在乎我",
+}
+
+// builtinBullying 是内置的遭受霸凌的短语, 命中后提醒人工关注, 可以通过配置补充
+var builtinBullying = []string{
+	"校园霸凌", "被霸凌", "被欺负", "他们欺负我", "被同学打", "他们打我", "被孤立", "被勒索", "被敲诈",
+	"被威胁", "逼我交钱", "被扇耳光", "被围殴", "没人跟我玩", "被嘲笑",
+}
@@ -0,0 +1,78 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util"
+	"golang.org/x/net/context"
+	"strconv"
+	"time"
+)
+
+// HeaderSignature 模拟网关回调携带签名的请求头
+const HeaderSignature = "X-Fake-Signature"
+
+// FakeGateway 在本地模拟支付网关, 回调用HMAC-SHA256签名
+// 可以通过Pay构造一次支付成功的回调, 从而离线走通下单、回调、到账和退款的完整流程
+type FakeGateway struct {
+	secret []byte
+}
+
+func NewFakeGateway(secret string) *FakeGateway {
+	return &FakeGateway{secret: []byte(secret)}
+}
+
+func (g *FakeGateway) Prepay(ctx context.Context, p *Prepay) (map[string]string, error) {
+	nonce, err := util.RandomCode("0123456789abcdef", 16)
+	if err != nil {
+		return nil, err
+	}
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	return map[string]string{
+		"orderId":   p.OrderId,
+		"amount":    strconv.FormatInt(p.Amount, 10),
+		"timeStamp": ts,
+		"nonceStr":  nonce,
+		"paySign":   g.sign([]byte(p.OrderId + ts + nonce)),
+	}, nil
+}
+
+func (g *FakeGateway) Verify(ctx context.Context, headers map[string]string, body []byte) (*Notification, error) {
+	if !hmac.Equal([]byte(headers[HeaderSignature]), []byte(g.sign(body))) {
+		return nil, ErrSignature
+	}
+	n := &Notification{}
+	if err := json.Unmarshal(body, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Refund 模拟网关总是退款成功
+func (g *FakeGateway) Refund(ctx context.Context, orderId, transactionId string, amount int64) error {
+	return nil
+}
+
+// Pay 模拟用户完成支付, 返回网关将要发送的回调请求头和请求体
+func (g *FakeGateway) Pay(orderId string, amount int64) (map[string]string, []byte, error) {
+	n := &Notification{
+		OrderId:       orderId,
+		TransactionId: "fake" + strconv.FormatInt(time.Now().UnixNano(), 10),
+		Amount:        amount,
+		TradeState:    TradeSuccess,
+		PayTime:       time.Now(),
+	}
+	body, err := json.Marshal(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	return map[string]string{HeaderSignature: g.sign(body)}, body, nil
+}
+
+func (g *FakeGateway) sign(data []byte) string {
+	h := hmac.New(sha256.New, g.secret)
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
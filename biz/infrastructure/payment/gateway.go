@@ -0,0 +1,71 @@
+package payment
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"golang.org/x/net/context"
+	"time"
+)
+
+// 网关类型
+const (
+	GatewayFake = "fake" // 本地模拟网关, 用于开发和测试
+)
+
+// 支付结果
+const (
+	TradeSuccess = "SUCCESS"
+)
+
+// Prepay 是向网关下单的参数
+type Prepay struct {
+	OrderId     string
+	Description string
+	Amount      int64 // 金额, 单位分
+}
+
+// Notification 是验签通过后的支付结果回调
+type Notification struct {
+	OrderId       string    `json:"orderId"`
+	TransactionId string    `json:"transactionId"` // 网关侧的交易号
+	Amount        int64     `json:"amount"`
+	TradeState    string    `json:"tradeState"`
+	PayTime       time.Time `json:"payTime"`
+}
+
+// NotifyAck 是对网关回调的应答
+type NotifyAck struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Gateway 是支付网关, 参照微信支付的下单、回调验签和退款流程
+type Gateway interface {
+	// Prepay 下单并返回客户端拉起支付所需的参数
+	Prepay(ctx context.Context, p *Prepay) (map[string]string, error)
+	// Verify 校验回调的签名并解析支付结果
+	Verify(ctx context.Context, headers map[string]string, body []byte) (*Notification, error)
+	// Refund 对已支付的交易全额退款
+	Refund(ctx context.Context, orderId, transactionId string, amount int64) error
+}
+
+var ErrSignature = errors.New("payment: invalid signature")
+
+// NewGateway 按配置创建支付网关, 没有支付配置时返回nil, 配置了但缺少网关或密钥时启动失败, 不会回退到模拟网关
+func NewGateway(config *config.Config) (Gateway, error) {
+	if config.Payment == nil {
+		return nil, nil
+	}
+	if config.Payment.Gateway == "" {
+		return nil, errors.New("payment: gateway is not configured")
+	}
+	if config.Payment.Secret == "" {
+		return nil, errors.New("payment: secret is not configured")
+	}
+	switch config.Payment.Gateway {
+	case GatewayFake:
+		return NewFakeGateway(config.Payment.Secret), nil
+	default:
+		return nil, errors.New("payment: unsupported gateway " + config.Payment.Gateway)
+	}
+}
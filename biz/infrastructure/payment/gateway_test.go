@@ -0,0 +1,62 @@
+package payment
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"golang.org/x/net/context"
+	"testing"
+)
+
+func TestNewGateway(t *testing.T) {
+	cases := []struct {
+		name    string
+		payment config.Payment
+		ok      bool
+	}{
+		{"missing gateway", config.Payment{Secret: "secret"}, false},
+		{"missing secret", config.Payment{Gateway: GatewayFake}, false},
+		{"unsupported gateway", config.Payment{Gateway: "unknown", Secret: "secret"}, false},
+		{"fake", config.Payment{Gateway: GatewayFake, Secret: "secret"}, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g, err := NewGateway(&config.Config{Payment: &c.payment})
+			if (err == nil) != c.ok || (g != nil) != c.ok {
+				t.Errorf("NewGateway(%+v) = (%v, %v), want ok %v", c.payment, g, err, c.ok)
+			}
+		})
+	}
+
+	// 没有支付配置时不创建网关, 也不阻止启动
+	if g, err := NewGateway(&config.Config{}); g != nil || err != nil {
+		t.Errorf("NewGateway without payment = (%v, %v), want (nil, nil)", g, err)
+	}
+}
+
+func TestFakeGatewayVerify(t *testing.T) {
+	ctx := context.Background()
+	g := NewFakeGateway("secret")
+	headers, body, err := g.Pay("order", 990)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := g.Verify(ctx, headers, body)
+	if err != nil {
+		t.Fatalf("Verify signed notification error %v", err)
+	}
+	if n.OrderId != "order" || n.Amount != 990 || n.TradeState != TradeSuccess {
+		t.Errorf("Verify = %+v", n)
+	}
+
+	// 伪造签名或篡改金额
+	if _, err = g.Verify(ctx, map[string]string{HeaderSignature: "forged"}, body); err != ErrSignature {
+		t.Errorf("Verify forged signature error = %v, want ErrSignature", err)
+	}
+	if _, err = g.Verify(ctx, headers, []byte(`{"orderId":"order","amount":1,"tradeState":"SUCCESS"}`)); err != ErrSignature {
+		t.Errorf("Verify tampered body error = %v, want ErrSignature", err)
+	}
+	// 其他密钥签名的回调
+	if _, err = NewFakeGateway("other").Verify(ctx, headers, body); err != ErrSignature {
+		t.Errorf("Verify with other secret error = %v, want ErrSignature", err)
+	}
+}
@@ -0,0 +1,42 @@
+package push
+
+import (
+	"errors"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"golang.org/x/net/context"
+)
+
+// 推送渠道
+const (
+	ChannelNoop = "noop" // 不推送, 用于本地开发和测试
+)
+
+// Message 是一条推送消息, 参照微信订阅消息的模板、跳转页面和数据
+type Message struct {
+	UserId  string
+	Type    string // 通知类型, 对应订阅消息的模板
+	Title   string
+	Content string
+	Page    string // 点击后跳转的页面
+}
+
+// Pusher 是站外推送渠道, 推送失败不影响站内通知
+type Pusher interface {
+	Push(ctx context.Context, m *Message) error
+}
+
+func NewPusher(config *config.Config) (Pusher, error) {
+	switch config.Notice.Channel {
+	case ChannelNoop:
+		return NoopPusher{}, nil
+	default:
+		return nil, errors.New("push: unsupported channel " + config.Notice.Channel)
+	}
+}
+
+// NoopPusher 丢弃所有推送消息
+type NoopPusher struct{}
+
+func (NoopPusher) Push(ctx context.Context, m *Message) error {
+	return nil
+}
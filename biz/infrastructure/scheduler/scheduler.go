@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/redis"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
+	"github.com/zeromicro/go-zero/core/threading"
+	"time"
+)
+
+// 提供简单的定时任务
+// 多实例部署时, 每次执行前会尝试获取redis锁, 锁的有效期为半个执行间隔且不主动释放
+// 保证同一任务在一个执行间隔内只会在一个实例上执行一次
+
+const prefixLockKey = "scheduler:"
+
+// Every 服务启动后立即执行一次fn, 此后每隔interval执行一次
+func Every(name string, interval time.Duration, fn func(ctx context.Context) error) {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			run(name, interval, fn)
+			<-ticker.C
+		}
+	})
+}
+
+func run(name string, interval time.Duration, fn func(ctx context.Context) error) {
+	ctx := context.Background()
+	expire := int(interval.Seconds() / 2)
+	if expire < 1 {
+		expire = 1
+	}
+	ok, err := redis.GetRedis(config.GetConfig()).SetnxExCtx(ctx, prefixLockKey+name, time.Now().String(), expire)
+	if err != nil {
+		log.Error("scheduler: %s lock error %v", name, err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	start := time.Now()
+	if err = fn(ctx); err != nil {
+		log.Error("scheduler: %s failed after %v, err=%v", name, time.Since(start), err)
+		return
+	}
+	log.Info("scheduler: %s finished in %v", name, time.Since(start))
+}
@@ -0,0 +1,124 @@
+package analysis
+
+import (
+	"strings"
+	"unicode"
+)
+
+type (
+	// Analysis 是批改前对作文的本地分析结果, 与批改记录一起保存
+	Analysis struct {
+		CharCount       int64               `bson:"char_count" json:"charCount"`  // 非空白字符数
+		WordCount       int64               `bson:"word_count" json:"wordCount"`  // 汉字数加上连续的字母数字串数
+		Paragraphs      [][]string          `bson:"paragraphs" json:"paragraphs"` // 按段落分组的句子
+		Punctuation     []*PunctuationIssue `bson:"punctuation" json:"punctuation"`
+		RepeatedPhrases []*Phrase           `bson:"repeated_phrases" json:"repeatedPhrases"`
+		Idioms          []*Phrase           `bson:"idioms" json:"idioms"`
+	}
+
+	// PunctuationIssue 是一处标点误用, 段落和句子的序号从0开始
+	PunctuationIssue struct {
+		Type      string `bson:"type" json:"type"`
+		Paragraph int64  `bson:"paragraph" json:"paragraph"`
+		Sentence  int64  `bson:"sentence" json:"sentence"`
+		Text      string `bson:"text" json:"text"` // 所在的句子
+	}
+
+	// Phrase 是一个短语及其出现次数
+	Phrase struct {
+		Text  string `bson:"text" json:"text"`
+		Count int64  `bson:"count" json:"count"`
+	}
+)
+
+// 句末标点和可以跟在句末标点之后的闭合标点
+const (
+	terminals = "。！？!?…"
+	closers   = "”’」』）)》"
+)
+
+// Analyze 分析作文, 不依赖外部服务
+func Analyze(text string) *Analysis {
+	a := &Analysis{Paragraphs: make([][]string, 0)}
+	inWord := false
+	for _, r := range text {
+		if unicode.IsSpace(r) {
+			inWord = false
+			continue
+		}
+		a.CharCount++
+		switch {
+		case unicode.Is(unicode.Han, r):
+			a.WordCount++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				a.WordCount++
+			}
+			inWord = true
+		default:
+			inWord = false
+		}
+	}
+	for _, p := range strings.Split(text, "\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			a.Paragraphs = append(a.Paragraphs, sentences(p))
+		}
+	}
+	a.Punctuation = punctuation(a.Paragraphs)
+	a.RepeatedPhrases = repeatedPhrases(a.Paragraphs)
+	a.Idioms = idioms(a.Paragraphs)
+	return a
+}
+
+// SentenceCount 返回句子总数
+func (a *Analysis) SentenceCount() int64 {
+	var n int64
+	for _, p := range a.Paragraphs {
+		n += int64(len(p))
+	}
+	return n
+}
+
+// sentences 按句末标点切分段落, 句末标点之后的闭合引号和括号归入前一句
+func sentences(p string) []string {
+	rs := []rune(p)
+	ss := make([]string, 0)
+	start := 0
+	for i := 0; i < len(rs); i++ {
+		if !strings.ContainsRune(terminals, rs[i]) {
+			continue
+		}
+		for i+1 < len(rs) && (strings.ContainsRune(terminals, rs[i+1]) || strings.ContainsRune(closers, rs[i+1])) {
+			i++
+		}
+		if s := strings.TrimSpace(string(rs[start : i+1])); s != "" {
+			ss = append(ss, s)
+		}
+		start = i + 1
+	}
+	if s := strings.TrimSpace(string(rs[start:])); s != "" {
+		ss = append(ss, s)
+	}
+	return ss
+}
+
+// hanRuns 将句子按非汉字字符切分为连续的汉字串
+func hanRuns(s string) [][]rune {
+	runs := make([][]rune, 0)
+	cur := make([]rune, 0)
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			cur = append(cur, r)
+			continue
+		}
+		if len(cur) > 0 {
+			runs = append(runs, cur)
+			cur = make([]rune, 0)
+		}
+	}
+	if len(cur) > 0 {
+		runs = append(runs, cur)
+	}
+	return runs
+}
@@ -0,0 +1,44 @@
+package analysis
+
+// builtinIdioms 是内置的常用四字成语, 可以通过配置补充
+var builtinIdioms = []string{
+	"一帆风顺", "一心一意", "一鸣惊人", "一丝不苟", "一目了然", "一举两得", "一针见血", "一往无前", "一马当先", "一望无际",
+	"一尘不染", "一见如故", "一劳永逸", "一如既往", "一事无成", "一言为定", "一日千里", "一模一样", "一清二楚", "一知半解",
+	"七上八下", "三心二意", "九牛一毛", "十全十美", "千方百计", "千钧一发", "千变万化", "千辛万苦", "万紫千红", "万众一心",
+	"万无一失", "万象更新", "五光十色", "五彩缤纷", "四面八方", "半途而废", "百花齐放", "百发百中", "百折不挠", "独一无二",
+	"井井有条", "井底之蛙", "画蛇添足", "画龙点睛", "守株待兔", "亡羊补牢", "掩耳盗铃", "对牛弹琴", "狐假虎威", "刻舟求剑",
+	"拔苗助长", "杯弓蛇影", "叶公好龙", "自相矛盾", "望梅止渴", "胸有成竹", "卧薪尝胆", "破釜沉舟", "纸上谈兵", "精卫填海",
+	"愚公移山", "滥竽充数", "惊弓之鸟", "闻鸡起舞", "负荆请罪", "完璧归赵", "指鹿为马", "草木皆兵", "三顾茅庐", "塞翁失马",
+	"熟能生巧", "水滴石穿", "持之以恒", "锲而不舍", "坚持不懈", "全力以赴", "专心致志", "聚精会神", "废寝忘食", "孜孜不倦",
+	"勤学好问", "学以致用", "举一反三", "温故知新", "融会贯通", "循序渐进", "不耻下问", "好学不倦", "博览群书", "学富五车",
+	"才高八斗", "出类拔萃", "与众不同", "独具匠心", "别出心裁", "津津有味", "兴高采烈", "欢天喜地", "喜出望外", "心花怒放",
+	"手舞足蹈", "眉开眼笑", "捧腹大笑", "忍俊不禁", "垂头丧气", "闷闷不乐", "愁眉苦脸", "心急如焚", "忐忑不安",
+	"惊慌失措", "提心吊胆", "大惊失色", "目瞪口呆", "呆若木鸡", "若有所思", "恍然大悟", "豁然开朗", "茅塞顿开", "心旷神怡",
+	"赏心悦目", "流连忘返", "美不胜收", "琳琅满目", "鸟语花香", "春暖花开", "春意盎然", "生机勃勃", "欣欣向荣", "郁郁葱葱",
+	"绿树成荫", "波光粼粼", "风和日丽", "秋高气爽", "天高云淡", "金风送爽", "硕果累累", "五谷丰登", "白雪皑皑", "银装素裹",
+	"寒风刺骨", "冰天雪地", "狂风暴雨", "电闪雷鸣", "倾盆大雨", "风雨交加", "雨过天晴", "晴空万里", "万里无云", "日新月异",
+	"翻天覆地", "天翻地覆", "人山人海", "川流不息", "车水马龙", "熙熙攘攘", "络绎不绝", "座无虚席", "门庭若市", "鸦雀无声",
+	"万籁俱寂", "寂静无声", "震耳欲聋", "此起彼伏", "争先恐后", "迫不及待", "小心翼翼", "轻手轻脚", "蹑手蹑脚", "大摇大摆",
+	"慢条斯理", "东张西望", "左顾右盼", "目不转睛", "全神贯注", "一本正经", "若无其事", "无精打采", "精神抖擞", "神采奕奕",
+	"容光焕发", "满面春风", "和蔼可亲", "平易近人", "彬彬有礼", "助人为乐", "舍己为人", "乐于助人", "拾金不昧", "见义勇为",
+	"奋不顾身", "视死如归", "英勇无畏", "临危不惧", "大义凛然", "光明磊落", "表里如一", "言而有信", "诚实守信", "实事求是",
+	"脚踏实地", "兢兢业业", "任劳任怨", "尽心尽力", "无微不至", "关怀备至", "嘘寒问暖", "语重心长", "苦口婆心", "循循善诱",
+	"诲人不倦", "春风化雨", "饮水思源", "知恩图报", "感恩戴德", "刻骨铭心", "念念不忘", "恋恋不舍", "依依不舍",
+	"难舍难分", "情同手足", "亲密无间", "形影不离", "志同道合", "同舟共济", "齐心协力", "众志成城", "团结一心", "取长补短",
+	"相辅相成", "各抒己见", "集思广益", "畅所欲言", "滔滔不绝", "口若悬河", "侃侃而谈", "娓娓动听", "妙语连珠", "出口成章",
+	"下笔成章", "文思泉涌", "妙笔生花", "栩栩如生", "惟妙惟肖", "活灵活现", "跃然纸上", "引人入胜", "扣人心弦", "动人心弦",
+	"感人肺腑", "催人泪下", "热泪盈眶", "泪流满面", "泣不成声", "悲痛欲绝", "痛哭流涕", "怒发冲冠", "火冒三丈", "暴跳如雷",
+	"气急败坏", "心平气和", "不慌不忙", "从容不迫", "信心百倍", "雄心勃勃", "壮志凌云", "志存高远", "鹏程万里",
+	"前程似锦", "蒸蒸日上", "突飞猛进", "勇往直前", "乘风破浪", "披荆斩棘", "迎难而上", "知难而进", "百尺竿头",
+	"精益求精", "力争上游", "不甘落后", "后来居上", "青出于蓝", "后生可畏", "少年老成", "光阴似箭", "日月如梭", "白驹过隙",
+	"岁月如流", "时光荏苒", "争分夺秒", "分秒必争", "只争朝夕", "来日方长", "不知不觉", "情不自禁", "不由自主",
+	"理所当然", "显而易见", "毋庸置疑", "千真万确", "名副其实", "名不虚传", "名列前茅", "独占鳌头", "首屈一指", "数一数二",
+	"无与伦比", "举世闻名", "家喻户晓", "众所周知", "脍炙人口", "有口皆碑", "赞不绝口", "拍手称快", "拍案叫绝", "叹为观止",
+	"大开眼界", "耳目一新", "焕然一新", "面目全非", "物是人非", "触景生情", "浮想联翩", "思绪万千", "百感交集", "感慨万千",
+	"心潮澎湃", "热血沸腾", "激动人心", "振奋人心", "大快人心", "心满意足", "称心如意", "如愿以偿", "梦寐以求", "望眼欲穿",
+	"翘首以盼", "日思夜想", "朝思暮想", "废话连篇", "画饼充饥", "杞人忧天", "井然有序", "有条不紊", "一成不变", "墨守成规",
+	"因循守旧", "推陈出新", "标新立异", "与时俱进", "继往开来", "承前启后", "源远流长", "博大精深", "历史悠久", "地大物博",
+	"山清水秀", "山明水秀", "世外桃源", "人间仙境", "巧夺天工", "鬼斧神工", "气势磅礴", "雄伟壮观", "高耸入云", "连绵起伏",
+	"崇山峻岭", "重峦叠嶂", "悬崖峭壁", "一望无垠", "无边无际", "浩浩荡荡", "汹涌澎湃", "波涛汹涌", "惊涛骇浪", "风平浪静",
+	"水平如镜", "清澈见底", "碧波荡漾", "姹紫嫣红", "花团锦簇", "争奇斗艳",
+}
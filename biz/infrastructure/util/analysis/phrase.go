@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 重复短语的长度范围
+const (
+	minPhrase = 4
+	maxPhrase = 8
+)
+
+var (
+	idiomSet  map[string]bool
+	idiomOnce sync.Once
+)
+
+// repeatedPhrases 统计多次出现的汉字短语, 被更长的短语以相同次数包含的短语不再单独列出
+func repeatedPhrases(ps [][]string) []*Phrase {
+	c := config.GetConfig().Analysis
+	counts := make(map[string]int64)
+	for _, p := range ps {
+		for _, s := range p {
+			for _, run := range hanRuns(s) {
+				for n := minPhrase; n <= maxPhrase; n++ {
+					for i := 0; i+n <= len(run); i++ {
+						counts[string(run[i:i+n])]++
+					}
+				}
+			}
+		}
+	}
+	repeated := make([]*Phrase, 0)
+	for t, n := range counts {
+		if n >= c.RepeatMin {
+			repeated = append(repeated, &Phrase{Text: t, Count: n})
+		}
+	}
+	// 先处理长短语, 以便判断短语是否被包含
+	sort.Slice(repeated, func(i, j int) bool {
+		if len(repeated[i].Text) != len(repeated[j].Text) {
+			return len(repeated[i].Text) > len(repeated[j].Text)
+		}
+		return repeated[i].Text < repeated[j].Text
+	})
+	kept := make([]*Phrase, 0)
+	for _, p := range repeated {
+		covered := false
+		for _, k := range kept {
+			if k.Count == p.Count && strings.Contains(k.Text, p.Text) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			kept = append(kept, p)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Count > kept[j].Count })
+	return kept[:min(int64(len(kept)), c.RepeatTop)]
+}
+
+// idioms 统计作文中使用的成语, 按首次出现的顺序
+func idioms(ps [][]string) []*Phrase {
+	idiomOnce.Do(func() {
+		idiomSet = make(map[string]bool, len(builtinIdioms))
+		for _, i := range builtinIdioms {
+			idiomSet[i] = true
+		}
+		for _, i := range config.GetConfig().Analysis.Idioms {
+			idiomSet[i] = true
+		}
+	})
+	found := make([]*Phrase, 0)
+	index := make(map[string]*Phrase)
+	for _, p := range ps {
+		for _, s := range p {
+			for _, run := range hanRuns(s) {
+				for i := 0; i+4 <= len(run); i++ {
+					t := string(run[i : i+4])
+					if !idiomSet[t] {
+						continue
+					}
+					if f, ok := index[t]; ok {
+						f.Count++
+					} else {
+						index[t] = &Phrase{Text: t, Count: 1}
+						found = append(found, index[t])
+					}
+					i += 3
+				}
+			}
+		}
+	}
+	return found
+}
@@ -0,0 +1,75 @@
+package analysis
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 标点误用的类型
+const (
+	IssueHalfWidth  = "half_width"  // 汉字之间使用了半角标点
+	IssueRepeated   = "repeated"    // 点号连用, 如"，，"或"，。", 问号和叹号连用除外
+	IssueLeading    = "leading"     // 句子以逗号、句号等点号开头
+	IssueMissingEnd = "missing_end" // 段落末尾缺少句末标点
+	IssueUnpaired   = "unpaired"    // 引号、括号或书名号不成对
+)
+
+const (
+	halfWidth = ",.;:?!"
+	points    = "，。、；：？！,.;:?!" // 点号, 省略号和破折号是标号, 可以连用
+	marks     = "？！?!"
+)
+
+// pairs 是需要成对出现的标点
+var pairs = [][2]rune{{'“', '”'}, {'‘', '’'}, {'（', '）'}, {'《', '》'}, {'「', '」'}}
+
+// punctuation 检查标点误用, 同一句的同类问题只报告一次
+func punctuation(ps [][]string) []*PunctuationIssue {
+	issues := make([]*PunctuationIssue, 0)
+	for i, p := range ps {
+		for j, s := range p {
+			seen := make(map[string]bool)
+			add := func(typ string) {
+				if !seen[typ] {
+					seen[typ] = true
+					issues = append(issues, &PunctuationIssue{Type: typ, Paragraph: int64(i), Sentence: int64(j), Text: s})
+				}
+			}
+			rs := []rune(s)
+			if strings.ContainsRune(points, rs[0]) {
+				add(IssueLeading)
+			}
+			for k, r := range rs {
+				if k > 0 && strings.ContainsRune(points, r) && strings.ContainsRune(points, rs[k-1]) &&
+					!(r != rs[k-1] && strings.ContainsRune(marks, r) && strings.ContainsRune(marks, rs[k-1])) {
+					add(IssueRepeated)
+				}
+				if k > 0 && k+1 < len(rs) && strings.ContainsRune(halfWidth, r) && unicode.Is(unicode.Han, rs[k-1]) && unicode.Is(unicode.Han, rs[k+1]) {
+					add(IssueHalfWidth)
+				}
+			}
+			if j == len(p)-1 && !strings.ContainsRune(terminals, lastPoint(rs)) {
+				add(IssueMissingEnd)
+			}
+		}
+		// 成对的标点可能跨句, 按段落检查
+		text := strings.Join(p, "")
+		for _, pr := range pairs {
+			if strings.Count(text, string(pr[0])) != strings.Count(text, string(pr[1])) {
+				issues = append(issues, &PunctuationIssue{Type: IssueUnpaired, Paragraph: int64(i), Sentence: int64(len(p) - 1), Text: string(pr[:])})
+				break
+			}
+		}
+	}
+	return issues
+}
+
+// lastPoint 返回句子去掉末尾闭合标点后的最后一个字符
+func lastPoint(rs []rune) rune {
+	for i := len(rs) - 1; i >= 0; i-- {
+		if !strings.ContainsRune(closers, rs[i]) {
+			return rs[i]
+		}
+	}
+	return 0
+}
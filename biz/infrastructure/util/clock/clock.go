@@ -0,0 +1,39 @@
+package clock
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"time"
+	_ "time/tzdata" // 运行环境可能没有时区数据
+)
+
+// Clock 提供业务时区下的当前时间, 日期相关的业务逻辑都应通过它获取时间, 以便在测试中替换
+type Clock interface {
+	Now() time.Time
+}
+
+type businessClock struct {
+	loc *time.Location
+}
+
+func NewClock(config *config.Config) (Clock, error) {
+	loc, err := time.LoadLocation(config.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+	return &businessClock{loc: loc}, nil
+}
+
+func (c *businessClock) Now() time.Time {
+	return time.Now().In(c.loc)
+}
+
+// Fixed 返回一个始终停在t的时钟, 时区与t相同
+func Fixed(t time.Time) Clock {
+	return fixedClock(t)
+}
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
@@ -1,12 +1,15 @@
 package util
 
 import (
+	"crypto/rand"
 	"errors"
 	"github.com/xh-polaris/essay-show/biz/application/dto/essay/show"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/consts"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/util/log"
 	"google.golang.org/grpc/codes"
+	"math/big"
 	"strconv"
+	"strings"
 	"sync"
 
 	"encoding/json"
@@ -58,6 +61,21 @@ func Contains[T comparable](s []T, e T) bool {
 	return false
 }
 
+// MaskName 对昵称打码, 仅保留首尾字符, 如 张三丰 -> 张*丰
+func MaskName(name string) string {
+	rs := []rune(name)
+	switch len(rs) {
+	case 0:
+		return ""
+	case 1:
+		return "*"
+	case 2:
+		return string(rs[0]) + "*"
+	default:
+		return string(rs[0]) + strings.Repeat("*", len(rs)-2) + string(rs[len(rs)-1])
+	}
+}
+
 func Succeed(msg string) (*show.Response, error) {
 	return &show.Response{
 		Code: 0,
@@ -85,3 +103,17 @@ func Fail(code int64, msg string) *show.Response {
 //
 //	return hashedSign
 //}
+
+// RandomCode 用密码学安全的随机源从alphabet中生成长度为n的随机串
+func RandomCode(alphabet string, n int) (string, error) {
+	max := big.NewInt(int64(len(alphabet)))
+	code := make([]byte, n)
+	for i := range code {
+		idx, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = alphabet[idx.Int64()]
+	}
+	return string(code), nil
+}
@@ -0,0 +1,72 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/zeromicro/go-zero/core/stores/mon"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// baseConfig 只填写必填项, 测试需要的其他配置在加载后直接修改返回的Config, 全局配置会随之改变
+const baseConfig = `Name: essay-show-test
+ListenOn: 0.0.0.0:0
+State: test
+Auth:
+  SecretKey: test
+  PublicKey: test
+  AccessExpire: 3600
+Mongo:
+  URL: %s
+  DB: %s
+Cache:
+  - Host: %s
+Redis:
+  Host: %s
+Coze:
+  Key: test
+  BotId: test
+Payment:
+  Gateway: fake
+  Secret: test
+`
+
+// LoadConfig 加载连接本地默认地址的配置并设为全局配置, 用于不访问数据库的测试
+func LoadConfig(t testing.TB) *config.Config {
+	return load(t, "mongodb://localhost:27017", "essay_show_test", "localhost:6379")
+}
+
+// LoadDBConfig 加载连接TEST_MONGO_URL和TEST_REDIS_HOST的配置并设为全局配置, 未设置时跳过测试
+// 每次使用新的数据库, 测试结束后删除
+func LoadDBConfig(t testing.TB) *config.Config {
+	mongoURL, redisHost := os.Getenv("TEST_MONGO_URL"), os.Getenv("TEST_REDIS_HOST")
+	if mongoURL == "" || redisHost == "" {
+		t.Skip("TEST_MONGO_URL or TEST_REDIS_HOST not set")
+	}
+	c := load(t, mongoURL, fmt.Sprintf("essay_show_test_%d", time.Now().UnixNano()), redisHost)
+	t.Cleanup(func() {
+		m := mon.MustNewModel(c.Mongo.URL, c.Mongo.DB, "drop")
+		if err := m.Database().Drop(context.Background()); err != nil {
+			t.Logf("drop test database %s error %v", c.Mongo.DB, err)
+		}
+	})
+	return c
+}
+
+func load(t testing.TB, mongoURL, db, redisHost string) *config.Config {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	yaml := fmt.Sprintf(baseConfig, mongoURL, db, redisHost, redisHost)
+	if err := os.WriteFile(path, []byte(yaml), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_PATH", path)
+	c, err := config.NewConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
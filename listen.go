@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/xh-polaris/essay-show/provider"
+)
+
+// listen 注册领域事件的订阅者
+func listen() {
+	p := provider.Get()
+
+	// 成就徽章
+	p.AchievementService.Subscribe()
+	// 补签卡
+	p.UserService.Subscribe()
+	// 站内通知
+	p.NotificationService.Subscribe()
+}
@@ -45,6 +45,8 @@ func main() {
 	})
 
 	register(h)
+	listen()
+	schedule()
 	log.Info("server start")
 	h.Spin()
 }
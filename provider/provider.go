@@ -4,13 +4,32 @@ import (
 	"github.com/google/wire"
 	"github.com/xh-polaris/essay-show/biz/application/service"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/achievement"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/audit"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/class"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/entitlement"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/exercise"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/feedback"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/invitation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/moderation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/order"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/prompt"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/rank"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/relation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/review"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/session"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/voucher"
+	moderationx "github.com/xh-polaris/essay-show/biz/infrastructure/moderation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/payment"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/push"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/rpc/platform_sts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
 )
 
 var provider *Provider
@@ -25,12 +44,26 @@ func Init() {
 
 // Provider 提供controller依赖的对象
 type Provider struct {
-	Config          *config.Config
-	UserService     service.UserService
-	EssayService    service.EssayService
-	StsService      service.StsService
-	ExerciseService service.ExerciseService
-	FeedBackService service.FeedBackService
+	Config              *config.Config
+	UserService         service.UserService
+	EssayService        service.EssayService
+	StsService          service.StsService
+	ExerciseService     service.ExerciseService
+	FeedBackService     service.FeedBackService
+	RankService         service.RankService
+	AchievementService  service.AchievementService
+	QuotaService        service.QuotaService
+	VoucherService      service.VoucherService
+	PlanService         service.PlanService
+	OrderService        service.OrderService
+	AdminService        service.AdminService
+	NotificationService service.NotificationService
+	AccountService      service.AccountService
+	RelationService     service.RelationService
+	ClassService        service.ClassService
+	ReviewService       service.ReviewService
+	PromptService       service.PromptService
+	ModerationService   service.ModerationService
 }
 
 func Get() *Provider {
@@ -47,6 +80,20 @@ var ApplicationSet = wire.NewSet(
 	service.StsServiceSet,
 	service.ExerciseServiceSet,
 	service.FeedbackServiceSet,
+	service.RankServiceSet,
+	service.AchievementServiceSet,
+	service.QuotaServiceSet,
+	service.VoucherServiceSet,
+	service.PlanServiceSet,
+	service.OrderServiceSet,
+	service.AdminServiceSet,
+	service.NotificationServiceSet,
+	service.AccountServiceSet,
+	service.RelationServiceSet,
+	service.ClassServiceSet,
+	service.ReviewServiceSet,
+	service.PromptServiceSet,
+	service.ModerationServiceSet,
 )
 
 var InfrastructureSet = wire.NewSet(
@@ -57,7 +104,34 @@ var InfrastructureSet = wire.NewSet(
 	attend.NewMongoMapper,
 	invitation.NewCodeMongoMapper,
 	invitation.NewLogMongoMapper,
+	exercise.NewReportMongoMapper,
 	feedback.NewMongoMapper,
+	rank.NewRedisMapper,
+	achievement.NewMongoMapper,
+	achievement.NewProgressMongoMapper,
+	ledger.NewMongoMapper,
+	voucher.NewBatchMongoMapper,
+	voucher.NewCodeMongoMapper,
+	voucher.NewRedemptionMongoMapper,
+	voucher.NewRedeemLimiter,
+	entitlement.NewMongoMapper,
+	order.NewMongoMapper,
+	payment.NewGateway,
+	audit.NewMongoMapper,
+	session.NewRedisMapper,
+	notification.NewMongoMapper,
+	push.NewPusher,
+	relation.NewMongoMapper,
+	relation.NewCodeRedisMapper,
+	class.NewClassMongoMapper,
+	class.NewMemberMongoMapper,
+	class.NewAssignmentMongoMapper,
+	review.NewMongoMapper,
+	prompt.NewMongoMapper,
+	moderation.NewMongoMapper,
+	moderationx.NewModerator,
+	event.NewBus,
+	clock.NewClock,
 	RpcSet,
 )
 
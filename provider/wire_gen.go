@@ -9,13 +9,32 @@ package provider
 import (
 	"github.com/xh-polaris/essay-show/biz/application/service"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/config"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/event"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/achievement"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/attend"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/audit"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/class"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/entitlement"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/exercise"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/feedback"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/invitation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/ledger"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/log"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/moderation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/notification"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/order"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/prompt"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/rank"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/relation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/review"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/session"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/user"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/mapper/voucher"
+	moderation2 "github.com/xh-polaris/essay-show/biz/infrastructure/moderation"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/payment"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/push"
 	"github.com/xh-polaris/essay-show/biz/infrastructure/rpc/platform_sts"
+	"github.com/xh-polaris/essay-show/biz/infrastructure/util/clock"
 )
 
 // Injectors from wire.go:
@@ -29,16 +48,107 @@ func NewProvider() (*Provider, error) {
 	attendMongoMapper := attend.NewMongoMapper(configConfig)
 	codeMongoMapper := invitation.NewCodeMongoMapper(configConfig)
 	logMongoMapper := invitation.NewLogMongoMapper(configConfig)
-	userService := service.UserService{
+	redisMapper := rank.NewRedisMapper(configConfig)
+	exerciseMongoMapper := exercise.NewMongoMapper(configConfig)
+	clockClock, err := clock.NewClock(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	rankService := &service.RankService{
+		RankMapper:     redisMapper,
+		UserMapper:     mongoMapper,
+		ExerciseMapper: exerciseMongoMapper,
+		AttendMapper:   attendMongoMapper,
+		Clock:          clockClock,
+	}
+	bus := event.NewBus()
+	ledgerMongoMapper := ledger.NewMongoMapper(configConfig)
+	quotaService := &service.QuotaService{
 		UserMapper:   mongoMapper,
-		AttendMapper: attendMongoMapper,
-		CodeMapper:   codeMongoMapper,
-		LogMapper:    logMongoMapper,
+		LedgerMapper: ledgerMongoMapper,
+	}
+	notificationMongoMapper := notification.NewMongoMapper(configConfig)
+	pusher, err := push.NewPusher(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	notificationService := &service.NotificationService{
+		NotificationMapper: notificationMongoMapper,
+		AttendMapper:       attendMongoMapper,
+		Pusher:             pusher,
+		Bus:                bus,
+		Clock:              clockClock,
+	}
+	entitlementMongoMapper := entitlement.NewMongoMapper(configConfig)
+	planService := &service.PlanService{
+		EntitlementMapper:   entitlementMongoMapper,
+		UserMapper:          mongoMapper,
+		Clock:               clockClock,
+		NotificationService: notificationService,
 	}
 	mongoMapper2 := log.NewMongoMapper(configConfig)
+	relationMongoMapper := relation.NewMongoMapper(configConfig)
+	codeRedisMapper := relation.NewCodeRedisMapper(configConfig)
+	relationService := &service.RelationService{
+		RelationMapper:      relationMongoMapper,
+		CodeMapper:          codeRedisMapper,
+		UserMapper:          mongoMapper,
+		EvaluateLogMapper:   mongoMapper2,
+		ExerciseMapper:      exerciseMongoMapper,
+		AttendMapper:        attendMongoMapper,
+		NotificationService: notificationService,
+		Clock:               clockClock,
+	}
+	classMongoMapper := class.NewClassMongoMapper(configConfig)
+	memberMongoMapper := class.NewMemberMongoMapper(configConfig)
+	assignmentMongoMapper := class.NewAssignmentMongoMapper(configConfig)
+	classService := &service.ClassService{
+		ClassMapper:       classMongoMapper,
+		MemberMapper:      memberMongoMapper,
+		AssignmentMapper:  assignmentMongoMapper,
+		UserMapper:        mongoMapper,
+		EvaluateLogMapper: mongoMapper2,
+		Clock:             clockClock,
+	}
+	userService := service.UserService{
+		UserMapper:        mongoMapper,
+		AttendMapper:      attendMongoMapper,
+		CodeMapper:        codeMongoMapper,
+		LogMapper:         logMongoMapper,
+		EvaluateLogMapper: mongoMapper2,
+		RankService:       rankService,
+		Bus:               bus,
+		Clock:             clockClock,
+		QuotaService:      quotaService,
+		PlanService:       planService,
+		RelationService:   relationService,
+	}
+	reviewMongoMapper := review.NewMongoMapper(configConfig)
+	promptMongoMapper := prompt.NewMongoMapper(configConfig)
+	promptService := &service.PromptService{
+		PromptMapper: promptMongoMapper,
+	}
+	moderationMongoMapper := moderation.NewMongoMapper(configConfig)
+	moderator, err := moderation2.NewModerator(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	moderationService := &service.ModerationService{
+		ModerationMapper:    moderationMongoMapper,
+		Moderator:           moderator,
+		NotificationService: notificationService,
+	}
 	essayService := service.EssayService{
-		LogMapper:  mongoMapper2,
-		UserMapper: mongoMapper,
+		LogMapper:         mongoMapper2,
+		UserMapper:        mongoMapper,
+		ReviewMapper:      reviewMongoMapper,
+		Bus:               bus,
+		QuotaService:      quotaService,
+		PlanService:       planService,
+		RelationService:   relationService,
+		ClassService:      classService,
+		PromptService:     promptService,
+		ModerationService: moderationService,
 	}
 	client := platform_sts.NewPlatformSts(configConfig)
 	platformSts := &platform_sts.PlatformSts{
@@ -48,24 +158,178 @@ func NewProvider() (*Provider, error) {
 		PlatformSts: platformSts,
 		UserMapper:  mongoMapper,
 	}
-	exerciseMongoMapper := exercise.NewMongoMapper(configConfig)
+	reportMongoMapper := exercise.NewReportMongoMapper(configConfig)
 	exerciseService := service.ExerciseService{
-		ExerciseMapper: exerciseMongoMapper,
-		LogMapper:      mongoMapper2,
-		UserMapper:     mongoMapper,
+		ExerciseMapper:  exerciseMongoMapper,
+		LogMapper:       mongoMapper2,
+		UserMapper:      mongoMapper,
+		ReportMapper:    reportMongoMapper,
+		RankService:     rankService,
+		Bus:             bus,
+		QuotaService:    quotaService,
+		RelationService: relationService,
 	}
 	feedbackMongoMapper := feedback.NewMongoMapper(configConfig)
 	feedBackService := service.FeedBackService{
-		FeedbackMapper: feedbackMongoMapper,
+		FeedbackMapper:      feedbackMongoMapper,
+		UserMapper:          mongoMapper,
+		LogMapper:           mongoMapper2,
+		ExerciseMapper:      exerciseMongoMapper,
+		NotificationService: notificationService,
+	}
+	serviceRankService := service.RankService{
+		RankMapper:     redisMapper,
 		UserMapper:     mongoMapper,
+		ExerciseMapper: exerciseMongoMapper,
+		AttendMapper:   attendMongoMapper,
+		Clock:          clockClock,
+	}
+	achievementMongoMapper := achievement.NewMongoMapper(configConfig)
+	progressMongoMapper := achievement.NewProgressMongoMapper(configConfig)
+	achievementService := service.AchievementService{
+		AchievementMapper: achievementMongoMapper,
+		ProgressMapper:    progressMongoMapper,
+		AttendMapper:      attendMongoMapper,
+		Bus:               bus,
+		Clock:             clockClock,
+		QuotaService:      quotaService,
+	}
+	serviceQuotaService := service.QuotaService{
+		UserMapper:   mongoMapper,
+		LedgerMapper: ledgerMongoMapper,
+	}
+	batchMongoMapper := voucher.NewBatchMongoMapper(configConfig)
+	voucherCodeMongoMapper := voucher.NewCodeMongoMapper(configConfig)
+	redemptionMongoMapper := voucher.NewRedemptionMongoMapper(configConfig)
+	redeemLimiter := voucher.NewRedeemLimiter(configConfig)
+	voucherService := service.VoucherService{
+		BatchMapper:      batchMongoMapper,
+		CodeMapper:       voucherCodeMongoMapper,
+		RedemptionMapper: redemptionMongoMapper,
+		Limiter:          redeemLimiter,
+		MemberMapper:     memberMongoMapper,
+		ClassMapper:      classMongoMapper,
+		QuotaService:     quotaService,
+	}
+	servicePlanService := service.PlanService{
+		EntitlementMapper:   entitlementMongoMapper,
+		UserMapper:          mongoMapper,
+		Clock:               clockClock,
+		NotificationService: notificationService,
+	}
+	orderMongoMapper := order.NewMongoMapper(configConfig)
+	gateway, err := payment.NewGateway(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	orderService := service.OrderService{
+		OrderMapper:  orderMongoMapper,
+		UserMapper:   mongoMapper,
+		Gateway:      gateway,
+		QuotaService: quotaService,
+		PlanService:  planService,
+		Clock:        clockClock,
+	}
+	auditMongoMapper := audit.NewMongoMapper(configConfig)
+	sessionRedisMapper := session.NewRedisMapper(configConfig)
+	adminService := service.AdminService{
+		UserMapper:          mongoMapper,
+		EvaluateLogMapper:   mongoMapper2,
+		CodeMapper:          codeMongoMapper,
+		InvitationMapper:    logMongoMapper,
+		AuditMapper:         auditMongoMapper,
+		SessionMapper:       sessionRedisMapper,
+		QuotaService:        quotaService,
+		PlanService:         planService,
+		Clock:               clockClock,
+		NotificationService: notificationService,
+	}
+	serviceNotificationService := service.NotificationService{
+		NotificationMapper: notificationMongoMapper,
+		AttendMapper:       attendMongoMapper,
+		Pusher:             pusher,
+		Bus:                bus,
+		Clock:              clockClock,
+	}
+	accountService := service.AccountService{
+		UserMapper:         mongoMapper,
+		AttendMapper:       attendMongoMapper,
+		CodeMapper:         codeMongoMapper,
+		InvitationMapper:   logMongoMapper,
+		EvaluateLogMapper:  mongoMapper2,
+		ExerciseMapper:     exerciseMongoMapper,
+		ReportMapper:       reportMongoMapper,
+		FeedbackMapper:     feedbackMongoMapper,
+		AchievementMapper:  achievementMongoMapper,
+		ProgressMapper:     progressMongoMapper,
+		NotificationMapper: notificationMongoMapper,
+		SessionMapper:      sessionRedisMapper,
+		RelationMapper:     relationMongoMapper,
+		MemberMapper:       memberMongoMapper,
+		ClassMapper:        classMongoMapper,
+		AssignmentMapper:   assignmentMongoMapper,
+		ReviewMapper:       reviewMongoMapper,
+		ModerationMapper:   moderationMongoMapper,
+		RedemptionMapper:   redemptionMongoMapper,
+		RankService:        rankService,
+		QuotaService:       quotaService,
+		Clock:              clockClock,
+	}
+	serviceRelationService := service.RelationService{
+		RelationMapper:      relationMongoMapper,
+		CodeMapper:          codeRedisMapper,
+		UserMapper:          mongoMapper,
+		EvaluateLogMapper:   mongoMapper2,
+		ExerciseMapper:      exerciseMongoMapper,
+		AttendMapper:        attendMongoMapper,
+		NotificationService: notificationService,
+		Clock:               clockClock,
+	}
+	serviceClassService := service.ClassService{
+		ClassMapper:       classMongoMapper,
+		MemberMapper:      memberMongoMapper,
+		AssignmentMapper:  assignmentMongoMapper,
+		UserMapper:        mongoMapper,
+		EvaluateLogMapper: mongoMapper2,
+		Clock:             clockClock,
+	}
+	reviewService := service.ReviewService{
+		ReviewMapper:        reviewMongoMapper,
+		LogMapper:           mongoMapper2,
+		UserMapper:          mongoMapper,
+		ClassService:        classService,
+		RelationService:     relationService,
+		NotificationService: notificationService,
+	}
+	servicePromptService := service.PromptService{
+		PromptMapper: promptMongoMapper,
+	}
+	serviceModerationService := service.ModerationService{
+		ModerationMapper:    moderationMongoMapper,
+		Moderator:           moderator,
+		NotificationService: notificationService,
 	}
 	providerProvider := &Provider{
-		Config:          configConfig,
-		UserService:     userService,
-		EssayService:    essayService,
-		StsService:      stsService,
-		ExerciseService: exerciseService,
-		FeedBackService: feedBackService,
+		Config:              configConfig,
+		UserService:         userService,
+		EssayService:        essayService,
+		StsService:          stsService,
+		ExerciseService:     exerciseService,
+		FeedBackService:     feedBackService,
+		RankService:         serviceRankService,
+		AchievementService:  achievementService,
+		QuotaService:        serviceQuotaService,
+		VoucherService:      voucherService,
+		PlanService:         servicePlanService,
+		OrderService:        orderService,
+		AdminService:        adminService,
+		NotificationService: serviceNotificationService,
+		AccountService:      accountService,
+		RelationService:     serviceRelationService,
+		ClassService:        serviceClassService,
+		ReviewService:       reviewService,
+		PromptService:       servicePromptService,
+		ModerationService:   serviceModerationService,
 	}
 	return providerProvider, nil
 }
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"github.com/xh-polaris/essay-show/biz/infrastructure/scheduler"
+	"github.com/xh-polaris/essay-show/provider"
+	"time"
+)
+
+// schedule 注册定时任务
+func schedule() {
+	p := provider.Get()
+
+	// 每小时全量重建排行榜
+	scheduler.Every("rank", time.Hour, p.RankService.Rebuild)
+	// 重置到期的套餐每月额度
+	scheduler.Every("plan", 10*time.Minute, p.PlanService.ResetMonthly)
+	// 提醒连续签到即将中断的用户
+	scheduler.Every("streak", time.Hour, p.NotificationService.RemindStreak)
+	// 清除注销冷静期已过的账号数据
+	scheduler.Every("account", time.Hour, p.AccountService.Purge)
+	// 为支付后未到账的订单补发权益
+	if p.Config.Payment != nil {
+		scheduler.Every("order", 5*time.Minute, p.OrderService.Sweep)
+	}
+	// 为激活后未发放完的邀请补发奖励
+	scheduler.Every("invitation", 5*time.Minute, p.UserService.SweepInvitations)
+}